@@ -2,16 +2,36 @@ package cmd
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/phogolabs/cli"
 	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/service"
 	"github.com/phogolabs/stride/terminal"
 	"github.com/phogolabs/stride/torrent"
 )
 
+// the spec that is used if neither the flag nor the config set it
+const defaultSpec = "./swagger.yaml"
+
 func get(ctx *cli.Context, key string) (string, error) {
+	source := ctx.String(key)
+
+	if source == "" {
+		config, err := configOf(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		source = config.Spec
+	}
+
+	if source == "" {
+		source = defaultSpec
+	}
+
 	// get the spec async
-	task, err := torrent.GetAsync(ctx.String(key))
+	task, err := torrent.GetAsync(source)
 	if err != nil {
 		return "", err
 	}
@@ -29,6 +49,33 @@ func get(ctx *cli.Context, key string) (string, error) {
 	return path, nil
 }
 
+// configOf returns the configuration of the project directory. The commands
+// without a project-path flag use the working directory.
+func configOf(ctx *cli.Context) (*service.Config, error) {
+	if config, ok := ctx.Metadata["config"].(*service.Config); ok {
+		return config, nil
+	}
+
+	dir := ctx.String("project-path")
+
+	if dir == "" {
+		dir = "."
+	}
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := service.LoadConfig(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Metadata["config"] = config
+	return config, nil
+}
+
 func reporter(ctx *cli.Context) contract.Reporter {
 	return &terminal.Reporter{
 		Writer: ctx.ErrWriter,
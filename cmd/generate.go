@@ -1,15 +1,21 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
+	"text/tabwriter"
 
 	"github.com/phogolabs/cli"
 	"github.com/phogolabs/log"
 	"github.com/phogolabs/log/handler/console"
 	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/plugin"
 	"github.com/phogolabs/stride/service"
+	"github.com/phogolabs/stride/syntax/csharp"
 	"github.com/phogolabs/stride/syntax/golang"
 	"github.com/phogolabs/stride/syntax/markdown"
+	"github.com/phogolabs/stride/syntax/typescript"
 )
 
 // OpenAPIGenerator provides a subcommands to generate source code from OpenAPI specification
@@ -26,8 +32,7 @@ func (m *OpenAPIGenerator) CreateCommand() *cli.Command {
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:  "file-path, f",
-				Usage: "path to the open api specification",
-				Value: "./swagger.yaml",
+				Usage: "path to the open api specification (defaults to the spec of stride.yaml or ./swagger.yaml)",
 			},
 			&cli.StringFlag{
 				Name:   "project-path, p",
@@ -35,6 +40,26 @@ func (m *OpenAPIGenerator) CreateCommand() *cli.Command {
 				Value:  ".",
 				EnvVar: "PWD",
 			},
+			&cli.BoolFlag{
+				Name:  "validation",
+				Usage: "generates a Validate method for every schema type",
+			},
+			&cli.StringFlag{
+				Name:  "module",
+				Usage: "import path of the project directory if there is no go.mod file",
+			},
+			&cli.StringSliceFlag{
+				Name:  "lang, target",
+				Usage: "name of the generator that should run (repeatable, see --list)",
+			},
+			&cli.StringSliceFlag{
+				Name:  "opt",
+				Usage: "option of a generator in the form <generator>.<name>=<value> (repeatable)",
+			},
+			&cli.BoolFlag{
+				Name:  "list",
+				Usage: "lists the available generators and their options",
+			},
 		},
 	}
 }
@@ -45,35 +70,218 @@ func (m *OpenAPIGenerator) before(ctx *cli.Context) error {
 }
 
 func (m *OpenAPIGenerator) generate(ctx *cli.Context) error {
-	// get the spec
-	path, err := get(ctx, "file-path")
+	registry, err := m.registry(ctx)
 	if err != nil {
 		return err
 	}
 
+	if ctx.Bool("list") {
+		return m.list(ctx, registry)
+	}
+
 	dir, err := filepath.Abs(ctx.String("project-path"))
 	if err != nil {
 		return err
 	}
 
+	config, err := configOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := ctx.StringSlice("lang")
+
+	if len(names) == 0 {
+		names = config.Generators
+	}
+
+	// the go package and its documentation are generated by default
+	if len(names) == 0 {
+		names = []string{"golang", "markdown"}
+	}
+
+	// the flags have precedence over the config
+	options := config.Arguments()
+
+	if ctx.Bool("validation") {
+		options = append(options, "golang.validation=true")
+	}
+
+	if module := ctx.String("module"); module != "" {
+		options = append(options, "golang.module="+module, "markdown.module="+module)
+	}
+
+	options = append(options, ctx.StringSlice("opt")...)
+
+	syntax, err := registry.Create(&service.GeneratorSelection{
+		Names:    names,
+		Options:  options,
+		Path:     dir,
+		Reporter: reporter(ctx),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// get the spec
+	path, err := get(ctx, "file-path")
+	if err != nil {
+		return err
+	}
+
 	// generate the soec
 	generator := &service.Generator{
 		Path: path,
 		Resolver: &codedom.Resolver{
 			Reporter: reporter(ctx),
 			Cache:    codedom.TypeDescriptorMap{},
+			Types:    config.TypeMapping(),
+			Names:    config.NameMapping(),
+		},
+		Generator: syntax,
+	}
+
+	return generator.Generate()
+}
+
+func (m *OpenAPIGenerator) list(ctx *cli.Context, registry *service.GeneratorRegistry) error {
+	writer := tabwriter.NewWriter(ctx.Writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(writer, "GENERATOR\tOPTION\tDESCRIPTION")
+
+	for _, descriptor := range registry.Descriptors() {
+		fmt.Fprintf(writer, "%s\t\t%s\n", descriptor.Name, descriptor.Description)
+
+		for _, option := range descriptor.Options {
+			fmt.Fprintf(writer, "\t%s.%s\t%s\n", descriptor.Name, option.Name, option.Usage)
+		}
+	}
+
+	return writer.Flush()
+}
+
+func (m *OpenAPIGenerator) plugin(executable *plugin.Executable) service.GeneratorFactory {
+	return func(config *service.GeneratorConfig) (service.SyntaxGenerator, error) {
+		generator := &plugin.Generator{
+			Name:     executable.Name,
+			Command:  executable.Command,
+			Path:     config.Path,
+			Options:  config.Options,
+			Reporter: config.Reporter,
+		}
+
+		return generator, nil
+	}
+}
+
+func (m *OpenAPIGenerator) registry(ctx *cli.Context) (*service.GeneratorRegistry, error) {
+	registry := &service.GeneratorRegistry{}
+
+	descriptors := []*service.GeneratorDescriptor{
+		{
+			Name:        "golang",
+			Description: "Go server and client packages",
+			Options: []*service.GeneratorOption{
+				{Name: "validation", Usage: "generates a Validate method for every schema type (true or false)"},
+				{Name: "module", Usage: "import path of the project directory if there is no go.mod file"},
+				{Name: "gomod", Usage: "scaffolds a go.mod file if the project is not part of a module (true or false)"},
+				{Name: "package", Usage: "directory of the models and the handlers package (defaults to service)"},
+				{Name: "models", Usage: "directory of the models package (overrides package)"},
+				{Name: "handlers", Usage: "directory of the handlers package (overrides package)"},
+				{Name: "command", Usage: "name of the command that runs the server (defaults to the project directory name)"},
+			},
+			Factory: func(config *service.GeneratorConfig) (service.SyntaxGenerator, error) {
+				validation, err := config.Options.Bool("validation")
+				if err != nil {
+					return nil, err
+				}
+
+				gomod, err := config.Options.Bool("gomod")
+				if err != nil {
+					return nil, err
+				}
+
+				pkg := config.Options.String("package", "")
+
+				generator := &golang.Generator{
+					Reporter:   config.Reporter,
+					Path:       config.Path,
+					Validation: validation,
+					Module:     config.Options.String("module", ""),
+					GoMod:      gomod,
+					Models:     config.Options.String("models", pkg),
+					Handlers:   config.Options.String("handlers", pkg),
+					Command:    config.Options.String("command", ""),
+				}
+
+				return generator, nil
+			},
+		},
+		{
+			Name:        "markdown",
+			Description: "Markdown documentation of the controllers",
+			Options: []*service.GeneratorOption{
+				{Name: "module", Usage: "import path of the project directory if there is no go.mod file"},
+			},
+			Factory: func(config *service.GeneratorConfig) (service.SyntaxGenerator, error) {
+				generator := &markdown.Generator{
+					Reporter: config.Reporter,
+					Path:     config.Path,
+					Module:   config.Options.String("module", ""),
+				}
+
+				return generator, nil
+			},
+		},
+		{
+			Name:        "typescript",
+			Description: "TypeScript schema types and a fetch based client",
+			Factory: func(config *service.GeneratorConfig) (service.SyntaxGenerator, error) {
+				generator := &typescript.Generator{
+					Reporter: config.Reporter,
+					Path:     config.Path,
+				}
+
+				return generator, nil
+			},
 		},
-		Generator: service.CompositeGenerator{
-			&golang.Generator{
-				Reporter: reporter(ctx),
-				Path:     dir,
+		{
+			Name:        "csharp",
+			Description: "ASP.NET Core Web API",
+			Options: []*service.GeneratorOption{
+				{Name: "namespace", Usage: "root namespace of the project (defaults to the directory name)"},
 			},
-			&markdown.Generator{
-				Reporter: reporter(ctx),
-				Path:     dir,
+			Factory: func(config *service.GeneratorConfig) (service.SyntaxGenerator, error) {
+				generator := &csharp.Generator{
+					Reporter:  config.Reporter,
+					Path:      config.Path,
+					Namespace: config.Options.String("namespace", ""),
+				}
+
+				return generator, nil
 			},
 		},
 	}
 
-	return generator.Generate()
+	for _, descriptor := range descriptors {
+		if err := registry.Register(descriptor); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, executable := range plugin.Discover(filepath.SplitList(os.Getenv("PATH"))) {
+		descriptor := &service.GeneratorDescriptor{
+			Name:        executable.Name,
+			Description: fmt.Sprintf("plugin %s", executable.Command),
+			AnyOption:   true,
+			Factory:     m.plugin(executable),
+		}
+
+		if err := registry.Register(descriptor); err != nil {
+			reporter(ctx).Warn(" Skipping plugin: %s. The built-in generator has the same name", executable.Command)
+		}
+	}
+
+	return registry, nil
 }
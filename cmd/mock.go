@@ -1,12 +1,15 @@
 package cmd
 
 import (
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/phogolabs/cli"
 	"github.com/phogolabs/log"
 	"github.com/phogolabs/log/handler/console"
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/service"
 )
 
-// OpenAPIMocker provides a subcommands to generate source code from OpenAPI specification
+// OpenAPIMocker provides a subcommands to run a mock server from OpenAPI specification
 type OpenAPIMocker struct{}
 
 // CreateCommand creates a cli.Command that can be used by cli.App.
@@ -18,10 +21,19 @@ func (m *OpenAPIMocker) CreateCommand() *cli.Command {
 		Before:      m.before,
 		Action:      m.mock,
 		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "listen-addr",
+				Usage: "address on which the http server is listening on",
+				Value: "localhost:8080",
+			},
 			&cli.StringFlag{
 				Name:  "file-path, f",
-				Usage: "path to the open api specification",
-				Value: "./swagger.yaml",
+				Usage: "path to the open api specification (defaults to the spec of stride.yaml or ./swagger.yaml)",
+			},
+			&cli.Int64Flag{
+				Name:  "seed",
+				Usage: "seed of the generated examples",
+				Value: 1,
 			},
 		},
 	}
@@ -33,5 +45,38 @@ func (m *OpenAPIMocker) before(ctx *cli.Context) error {
 }
 
 func (m *OpenAPIMocker) mock(ctx *cli.Context) error {
-	return nil
+	// get the spec
+	path, err := get(ctx, "file-path")
+	if err != nil {
+		return err
+	}
+
+	loader := openapi3.NewSwaggerLoader()
+
+	swagger, err := loader.LoadSwaggerFromFile(path)
+	if err != nil {
+		return err
+	}
+
+	resolver := &codedom.Resolver{
+		Reporter: reporter(ctx),
+		Cache:    codedom.TypeDescriptorMap{},
+	}
+
+	spec, err := resolver.Resolve(swagger)
+	if err != nil {
+		return err
+	}
+
+	var (
+		config = &service.MockerConfig{
+			Addr: ctx.String("listen-addr"),
+			Seed: ctx.Int64("seed"),
+			Spec: spec,
+		}
+		server = service.NewMocker(config)
+	)
+
+	log.Infof("http server is listening on http://%v", config.Addr)
+	return server.ListenAndServe()
 }
@@ -0,0 +1,76 @@
+// Command stride-gen-example is an example of a stride plugin. It writes the
+// routes and the types of the specification to a text file.
+//
+//	$ stride generate --lang example --opt example.file=routes.txt
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/plugin"
+)
+
+func main() {
+	if err := plugin.Serve(os.Stdin, os.Stdout, generate); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func generate(request *plugin.Request) ([]*plugin.File, error) {
+	name := "routes.txt"
+
+	for key, value := range request.Options {
+		switch key {
+		case "file":
+			name = value
+		default:
+			return nil, fmt.Errorf("unknown option: %v", key)
+		}
+	}
+
+	spec, err := plugin.Decode(request.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := &bytes.Buffer{}
+
+	for _, controller := range spec.Controllers {
+		for _, operation := range controller.Operations {
+			fmt.Fprintf(buffer, "%s %s %s\n", strings.ToUpper(operation.Method), operation.Path, operation.Name)
+		}
+	}
+
+	for _, descriptor := range spec.Types {
+		fmt.Fprintf(buffer, "type %s %s\n", descriptor.Name, kind(descriptor))
+	}
+
+	file := &plugin.File{
+		Name:    name,
+		Content: buffer.String(),
+	}
+
+	return []*plugin.File{file}, nil
+}
+
+func kind(descriptor *codedom.TypeDescriptor) string {
+	switch {
+	case descriptor.IsEnum:
+		return "enum"
+	case descriptor.IsUnion:
+		return "union"
+	case descriptor.IsClass:
+		return "class"
+	case descriptor.IsArray:
+		return "array"
+	case descriptor.IsMap:
+		return "map"
+	default:
+		return "alias"
+	}
+}
@@ -21,8 +21,7 @@ func (m *OpenAPIValidator) CreateCommand() *cli.Command {
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:  "file-path, f",
-				Usage: "path to the open api specification",
-				Value: "./swagger.yaml",
+				Usage: "path to the open api specification (defaults to the spec of stride.yaml or ./swagger.yaml)",
 			},
 		},
 	}
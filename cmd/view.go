@@ -26,8 +26,7 @@ func (m *OpenAPIViewer) CreateCommand() *cli.Command {
 			},
 			&cli.StringFlag{
 				Name:  "file-path, f",
-				Usage: "path to the open api specification",
-				Value: "./swagger.yaml",
+				Usage: "path to the open api specification (defaults to the spec of stride.yaml or ./swagger.yaml)",
 			},
 		},
 	}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -11,14 +12,53 @@ import (
 	"github.com/phogolabs/stride/inflect"
 )
 
+// escaper escapes the characters that the validator uses as separators and
+// the backtick that cannot be part of a raw string literal
+var escaper = strings.NewReplacer(",", "0x2C", "|", "0x7C", "`", "\\x60")
+
+// major matches the major version suffix of an import path
+var major = regexp.MustCompile(`^v[0-9]+$`)
+
+// kinds are the golang types of the formats that are not mapped
+var kinds = map[string]string{
+	"date-time": "time.Time",
+	"date":      "time.Time",
+	"uuid":      "github.com/phogolabs/schema.UUID",
+	"byte":      "[]byte",
+	// the file type is generated in the package of the models
+	"binary": "*File",
+}
+
 // Metadata of the TypeDescriptor
 type Metadata map[string]interface{}
 
+// HasPattern returns true if the type has a pattern
+func (m Metadata) HasPattern() bool {
+	value, ok := m["pattern"].(string)
+	return ok && value != ""
+}
+
+// HasMultipleOf returns true if the type has a multiple of constraint
+func (m Metadata) HasMultipleOf() bool {
+	value, ok := m["multiple_of"].(*float64)
+	return ok && value != nil
+}
+
+// identifier returns the name set by the x-go-name extension or the name
+func (m Metadata) identifier(name string) string {
+	if value, ok := m["go_name"].(string); ok && value != "" {
+		return value
+	}
+
+	return name
+}
+
 // SpecDescriptor represents a spec
 type SpecDescriptor struct {
-	Info        *InfoDescriptor
-	Types       TypeDescriptorCollection
-	Controllers ControllerDescriptorCollection
+	Info            *InfoDescriptor
+	Types           TypeDescriptorCollection
+	Controllers     ControllerDescriptorCollection
+	SecuritySchemes SecuritySchemeDescriptorCollection
 }
 
 // InfoDescriptor provides some information
@@ -108,10 +148,12 @@ type TypeDescriptor struct {
 	IsEnum      bool
 	IsPrimitive bool
 	IsAlias     bool
+	IsUnion     bool
 	IsNullable  bool
 	Key         *TypeDescriptor
 	Element     *TypeDescriptor
 	Default     interface{}
+	Example     interface{}
 	Metadata    Metadata
 	Properties  PropertyDescriptorCollection
 }
@@ -147,18 +189,20 @@ func (d *TypeDescriptor) Tags(required bool) TagDescriptorCollection {
 		tag.Options = append(tag.Options, "required")
 	}
 
-	can := func(exclusive bool) bool {
-		if !d.IsNullable {
-			if required {
-				return true
-			}
-		}
-		return exclusive
+	exclusive := func(key string) bool {
+		value, _ := d.Metadata[key].(bool)
+		return value
 	}
 
 	tags = append(tags, tag)
 
-	for k, v := range d.Metadata {
+	// the constraints are added in a stable order
+	for _, k := range []string{"min", "max", "multiple_of", "pattern", "unique", "values"} {
+		v, ok := d.Metadata[k]
+		if !ok {
+			continue
+		}
+
 		switch k {
 		case "unique":
 			if unique, ok := v.(bool); ok {
@@ -169,41 +213,41 @@ func (d *TypeDescriptor) Tags(required bool) TagDescriptorCollection {
 		case "pattern":
 			if value, ok := v.(string); ok {
 				if value != "" {
-					// TODO: add support for regex
-					// options = append(options, fmt.Sprintf("regex=%v", value))
+					tag.Options = append(tag.Options, fmt.Sprintf("regexp=%v", escaper.Replace(value)))
 				}
 			}
 		case "multiple_of":
 			if value, ok := v.(*float64); ok {
 				if value != nil {
-					tag.Options = append(tag.Options, fmt.Sprintf("multipleof=%v", value))
+					tag.Options = append(tag.Options, fmt.Sprintf("multipleof=%v", *value))
 				}
 			}
 		case "min":
 			if value, ok := v.(*float64); ok {
 				if value != nil {
-					if exclusive, ok := d.Metadata["min_exclusive"].(bool); ok {
-						if can(exclusive) {
-							tag.Options = append(tag.Options, fmt.Sprintf("gt=%v", *value))
-						} else {
-							tag.Options = append(tag.Options, fmt.Sprintf("gte=%v", *value))
-						}
+					if exclusive("min_exclusive") {
+						tag.Options = append(tag.Options, fmt.Sprintf("gt=%v", *value))
+					} else {
+						tag.Options = append(tag.Options, fmt.Sprintf("gte=%v", *value))
 					}
 				}
 			}
 		case "max":
 			if value, ok := v.(*float64); ok {
 				if value != nil {
-					if exclusive, ok := d.Metadata["max_exclusive"].(bool); ok {
-						if can(exclusive) {
-							tag.Options = append(tag.Options, fmt.Sprintf("lt=%v", *value))
-						} else {
-							tag.Options = append(tag.Options, fmt.Sprintf("lte=%v", *value))
-						}
+					if exclusive("max_exclusive") {
+						tag.Options = append(tag.Options, fmt.Sprintf("lt=%v", *value))
+					} else {
+						tag.Options = append(tag.Options, fmt.Sprintf("lte=%v", *value))
 					}
 				}
 			}
 		case "values":
+			// the oneof validation does not support floating point numbers
+			if item := d.Element; item != nil && (item.Name == "float32" || item.Name == "float64") {
+				continue
+			}
+
 			if values, ok := v.([]interface{}); ok {
 				if len(values) > 0 {
 					tag.Options = append(tag.Options, fmt.Sprintf("oneof=%v", oneof(values)))
@@ -232,35 +276,35 @@ func (d *TypeDescriptor) Tags(required bool) TagDescriptorCollection {
 
 // Namespace returns the namespace
 func (d *TypeDescriptor) Namespace() string {
-	switch d.Kind() {
-	case "time.Time":
-		return "time"
-	case "schema.UUID":
-		return "github.com/phogolabs/schema"
-	default:
+	namespace, _, _ := d.mapping()
+	return namespace
+}
+
+// ImportName returns the name of the namespace import if it's set by the
+// x-go-type-import extension
+func (d *TypeDescriptor) ImportName() string {
+	if !d.IsPrimitive {
 		return ""
 	}
+
+	name, _ := d.Metadata["go_import_name"].(string)
+	return name
 }
 
 // Kind returns the golang kind
 func (d *TypeDescriptor) Kind() string {
 	name := strings.ToLower(d.Name)
 
-	switch {
-	case name == "date-time":
-		name = "time.Time"
-	case name == "date":
-		name = "time.Time"
-	case name == "uuid":
-		name = "schema.UUID"
-	default:
+	if _, kind, ok := d.mapping(); ok {
+		name = kind
+	} else {
 		switch {
 		case d.IsAny:
 			name = "interface{}"
 		case d.IsMap:
 			name = fmt.Sprintf("map[%s]%s", d.Key.Kind(), d.Element.Kind())
 		case !d.IsPrimitive:
-			name = inflect.Camelize(d.Name)
+			name = inflect.Camelize(d.Identifier())
 		}
 	}
 
@@ -271,6 +315,77 @@ func (d *TypeDescriptor) Kind() string {
 	return name
 }
 
+// Identifier returns the name of the golang type
+func (d *TypeDescriptor) Identifier() string {
+	return d.Metadata.identifier(d.Name)
+}
+
+// mapping returns the import path and the name of the golang type
+func (d *TypeDescriptor) mapping() (string, string, bool) {
+	kind, ok := d.Metadata["go_type"].(string)
+
+	switch {
+	case ok && d.IsPrimitive:
+		// the type is qualified by the package name of the import
+		if namespace, ok := d.Metadata["go_import"].(string); ok {
+			return namespace, kind, true
+		}
+	case d.IsPrimitive:
+		if kind, ok = kinds[strings.ToLower(d.Name)]; !ok {
+			return "", "", false
+		}
+	default:
+		return "", "", false
+	}
+
+	star := ""
+
+	// the pointer types such as *math/big.Int
+	if strings.HasPrefix(kind, "*") {
+		star, kind = "*", kind[1:]
+	}
+
+	var (
+		slash = strings.LastIndex(kind, "/")
+		dot   = strings.LastIndex(kind, ".")
+	)
+
+	// builtin types such as int64 or []byte
+	if dot <= slash {
+		return "", star + kind, true
+	}
+
+	var (
+		namespace = kind[:dot]
+		parts     = strings.Split(namespace, "/")
+		pkg       = parts[len(parts)-1]
+	)
+
+	// the major version is not part of the package name
+	if len(parts) > 1 && major.MatchString(pkg) {
+		pkg = parts[len(parts)-2]
+	}
+
+	// gopkg.in/yaml.v2
+	if index := strings.Index(pkg, "."); index > 0 {
+		pkg = pkg[:index]
+	}
+
+	return namespace, star + pkg + kind[dot:], true
+}
+
+// IsBinary returns true if the type is a file such as string with binary
+// format
+func (d *TypeDescriptor) IsBinary() bool {
+	item := element(d)
+
+	if _, ok := item.Metadata["go_type"]; ok {
+		return false
+	}
+
+	return item.IsPrimitive && strings.EqualFold(item.Name, "binary")
+}
+
 // HasProperties returns true if the type has properties
 func (d *TypeDescriptor) HasProperties() bool {
 	return len(d.Properties) > 0
@@ -285,6 +400,35 @@ type PropertyDescriptor struct {
 	WriteOnly    bool
 	IsEmbedded   bool
 	PropertyType *TypeDescriptor
+	Metadata     Metadata
+}
+
+// Identifier returns the name of the golang field
+func (p *PropertyDescriptor) Identifier() string {
+	return p.Metadata.identifier(p.Name)
+}
+
+// Kind returns the golang kind of the field. The x-stride-pointer extension
+// overrides whether the field is a pointer.
+func (p *PropertyDescriptor) Kind() string {
+	kind := p.PropertyType.Kind()
+
+	if pointer, ok := p.Metadata["go_pointer"].(bool); ok {
+		if pointer {
+			return inflect.Pointer(kind)
+		}
+
+		return inflect.Unpointer(kind)
+	}
+
+	return kind
+}
+
+// IsOmitted returns true if the x-stride-omit extension excludes the field
+// from the golang type
+func (p *PropertyDescriptor) IsOmitted() bool {
+	omit, _ := p.Metadata["go_omit"].(bool)
+	return omit
 }
 
 // Tags returns the underlying tags
@@ -326,8 +470,9 @@ func (p *PropertyDescriptor) Tags() TagDescriptorCollection {
 	emit("form")
 	emit("field")
 
-	// validation
-	tags = append(tags, p.PropertyType.Tags(p.Required)...)
+	// validation of the read-only and write-only properties that are present
+	// in one direction only
+	tags = append(tags, p.PropertyType.Tags(p.Required && !p.ReadOnly && !p.WriteOnly)...)
 
 	return tags
 }
@@ -356,6 +501,11 @@ func (t PropertyDescriptorCollection) Less(i, j int) bool {
 		return strings.HasSuffix(value, "_id")
 	}
 
+	// the embedded types come first
+	if t[i].IsEmbedded != t[j].IsEmbedded {
+		return t[i].IsEmbedded
+	}
+
 	if isPrimaryKey(ni) || isForeignKey(ni) {
 		return true
 	}
@@ -382,6 +532,12 @@ type ParameterDescriptor struct {
 	Required      bool
 	Deprecated    bool
 	ParameterType *TypeDescriptor
+	Metadata      Metadata
+}
+
+// Identifier returns the name of the golang field
+func (p *ParameterDescriptor) Identifier() string {
+	return p.Metadata.identifier(p.Name)
 }
 
 // Tags returns the tags for this parameter
@@ -424,6 +580,10 @@ func (t ParameterDescriptorCollection) Len() int {
 // Less reports whether the element with
 // index i should sort before the element with index j.
 func (t ParameterDescriptorCollection) Less(i, j int) bool {
+	if t[i].Name == t[j].Name {
+		return t[i].In < t[j].In
+	}
+
 	return t[i].Name < t[j].Name
 }
 
@@ -437,8 +597,11 @@ type RequestDescriptor struct {
 	ContentType string
 	Description string
 	Required    bool
+	Example     interface{}
 	Parameters  ParameterDescriptorCollection
 	RequestType *TypeDescriptor
+	// Encoding maps the properties of a multipart body to their content types
+	Encoding map[string]string
 }
 
 // RequestDescriptorCollection definition
@@ -465,8 +628,10 @@ type ResponseDescriptor struct {
 	Code         int
 	Description  string
 	ContentType  string
+	Example      interface{}
 	ResponseType *TypeDescriptor
 	Parameters   ParameterDescriptorCollection
+	Links        LinkDescriptorCollection
 	IsDefault    bool
 }
 
@@ -498,6 +663,17 @@ func (t ResponseDescriptorCollection) Swap(i, j int) {
 	reflect.Swapper(t)(i, j)
 }
 
+// find returns the first response with the given code
+func (t ResponseDescriptorCollection) find(code int) *ResponseDescriptor {
+	for _, descriptor := range t {
+		if descriptor.Code == code {
+			return descriptor
+		}
+	}
+
+	return nil
+}
+
 // ControllerDescriptor definition
 type ControllerDescriptor struct {
 	Name        string
@@ -505,6 +681,17 @@ type ControllerDescriptor struct {
 	Operations  OperationDescriptorCollection
 }
 
+// IsSecured returns true if any of the operations requires authentication
+func (d *ControllerDescriptor) IsSecured() bool {
+	for _, operation := range d.Operations {
+		if operation.IsSecured() {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ControllerDescriptorMap definition
 type ControllerDescriptorMap map[string]*ControllerDescriptor
 
@@ -586,6 +773,22 @@ type OperationDescriptor struct {
 	Tags        []string
 	Requests    RequestDescriptorCollection
 	Responses   ResponseDescriptorCollection
+	// Security are the alternative security requirements of the operation.
+	// A requirement is satisfied if all of its schemes are satisfied.
+	Security []SecuritySchemeDescriptorCollection
+	// Callbacks are the requests that the operation sends to the subscribers
+	Callbacks CallbackDescriptorCollection
+	Metadata  Metadata
+}
+
+// IsSecured returns true if the operation requires authentication
+func (d *OperationDescriptor) IsSecured() bool {
+	return len(d.Security) > 0
+}
+
+// Identifier returns the name of the golang method
+func (d *OperationDescriptor) Identifier() string {
+	return d.Metadata.identifier(d.Name)
 }
 
 // DeprecationMessage returns the deprecation message
@@ -653,3 +856,113 @@ func element(descriptor *TypeDescriptor) *TypeDescriptor {
 
 	return element
 }
+
+// SecuritySchemeDescriptor definition
+type SecuritySchemeDescriptor struct {
+	Name        string
+	Description string
+	// Type is one of apiKey, http, oauth2 or openIdConnect
+	Type string
+	// In is the location of the api key (header, query or cookie)
+	In string
+	// Key is the name of the api key parameter
+	Key string
+	// Scheme is the lower case http authorization scheme such as basic or bearer
+	Scheme       string
+	BearerFormat string
+	// Scopes are the scopes required by the operation
+	Scopes []string
+}
+
+// SecuritySchemeDescriptorCollection definition
+type SecuritySchemeDescriptorCollection []*SecuritySchemeDescriptor
+
+// Len is the number of elements in the collection.
+func (t SecuritySchemeDescriptorCollection) Len() int {
+	return len(t)
+}
+
+// Less reports whether the element with
+// index i should sort before the element with index j.
+func (t SecuritySchemeDescriptorCollection) Less(i, j int) bool {
+	return t[i].Name < t[j].Name
+}
+
+// Swap swaps the elements with indexes i and j.
+func (t SecuritySchemeDescriptorCollection) Swap(i, j int) {
+	reflect.Swapper(t)(i, j)
+}
+
+func (t SecuritySchemeDescriptorCollection) find(name string) *SecuritySchemeDescriptor {
+	for _, descriptor := range t {
+		if descriptor.Name == name {
+			return descriptor
+		}
+	}
+
+	return nil
+}
+
+// CallbackDescriptor definition
+type CallbackDescriptor struct {
+	Name string
+	// Operations are the requests of the callback. Their path is the runtime
+	// expression of the subscriber url such as {$request.body#/callbackUrl}.
+	Operations OperationDescriptorCollection
+}
+
+// CallbackDescriptorCollection definition
+type CallbackDescriptorCollection []*CallbackDescriptor
+
+// Len is the number of elements in the collection.
+func (t CallbackDescriptorCollection) Len() int {
+	return len(t)
+}
+
+// Less reports whether the element with
+// index i should sort before the element with index j.
+func (t CallbackDescriptorCollection) Less(i, j int) bool {
+	return t[i].Name < t[j].Name
+}
+
+// Swap swaps the elements with indexes i and j.
+func (t CallbackDescriptorCollection) Swap(i, j int) {
+	reflect.Swapper(t)(i, j)
+}
+
+// LinkDescriptor definition
+type LinkDescriptor struct {
+	Name        string
+	Description string
+	// OperationID and OperationRef refer to the target operation
+	OperationID  string
+	OperationRef string
+	// Controller is the name of the controller of the target operation
+	Controller string
+	// Operation is the target operation
+	Operation *OperationDescriptor
+	// Parameters maps the parameters of the target operation to constants or
+	// runtime expressions such as $response.body#/id
+	Parameters map[string]string
+	// RequestBody is the constant or the runtime expression of the request body
+	RequestBody string
+}
+
+// LinkDescriptorCollection definition
+type LinkDescriptorCollection []*LinkDescriptor
+
+// Len is the number of elements in the collection.
+func (t LinkDescriptorCollection) Len() int {
+	return len(t)
+}
+
+// Less reports whether the element with
+// index i should sort before the element with index j.
+func (t LinkDescriptorCollection) Less(i, j int) bool {
+	return t[i].Name < t[j].Name
+}
+
+// Swap swaps the elements with indexes i and j.
+func (t LinkDescriptorCollection) Swap(i, j int) {
+	reflect.Swapper(t)(i, j)
+}
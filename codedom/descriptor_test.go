@@ -29,6 +29,95 @@ var _ = Describe("TypeDescriptor", func() {
 		ItReturnTheKind("uuid", "schema.UUID", true, false)
 		ItReturnTheKind("int", "int", true, false)
 		ItReturnTheKind("int", "*int", true, true)
+		ItReturnTheKind("byte", "[]byte", true, false)
+		ItReturnTheKind("binary", "*File", true, false)
+		ItReturnTheKind("binary", "*File", true, true)
+
+		Context("when the type is mapped", func() {
+			It("returns the mapped type", func() {
+				descriptor := &codedom.TypeDescriptor{
+					Name:        "string",
+					IsPrimitive: true,
+					IsNullable:  true,
+					Metadata: codedom.Metadata{
+						"go_type": "github.com/go-playground/validator/v10.FieldError",
+					},
+				}
+
+				Expect(descriptor.Kind()).To(Equal("*validator.FieldError"))
+				Expect(descriptor.Namespace()).To(Equal("github.com/go-playground/validator/v10"))
+			})
+
+			It("returns the builtin type", func() {
+				descriptor := &codedom.TypeDescriptor{
+					Name:        "string",
+					IsPrimitive: true,
+					Metadata: codedom.Metadata{
+						"go_type": "[]byte",
+					},
+				}
+
+				Expect(descriptor.Kind()).To(Equal("[]byte"))
+				Expect(descriptor.Namespace()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("IsBinary", func() {
+		It("returns true", func() {
+			descriptor := &codedom.TypeDescriptor{Name: "binary", IsPrimitive: true}
+			Expect(descriptor.IsBinary()).To(BeTrue())
+		})
+
+		Context("when the type is an alias", func() {
+			It("returns true", func() {
+				descriptor := &codedom.TypeDescriptor{
+					Name:    "avatar",
+					IsAlias: true,
+					Element: &codedom.TypeDescriptor{Name: "binary", IsPrimitive: true},
+				}
+
+				Expect(descriptor.IsBinary()).To(BeTrue())
+			})
+		})
+
+		Context("when the type is mapped", func() {
+			It("returns false", func() {
+				descriptor := &codedom.TypeDescriptor{
+					Name:        "binary",
+					IsPrimitive: true,
+					Metadata: codedom.Metadata{
+						"go_type": "[]byte",
+					},
+				}
+
+				Expect(descriptor.IsBinary()).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Identifier", func() {
+		It("returns the name", func() {
+			descriptor := &codedom.TypeDescriptor{Name: "api-key", IsClass: true, IsNullable: true}
+			Expect(descriptor.Identifier()).To(Equal("api-key"))
+			Expect(descriptor.Kind()).To(Equal("*ApiKey"))
+		})
+
+		Context("when the name is set by x-go-name", func() {
+			It("returns the golang name", func() {
+				descriptor := &codedom.TypeDescriptor{
+					Name:       "api-key",
+					IsClass:    true,
+					IsNullable: true,
+					Metadata: codedom.Metadata{
+						"go_name": "APIKey",
+					},
+				}
+
+				Expect(descriptor.Identifier()).To(Equal("APIKey"))
+				Expect(descriptor.Kind()).To(Equal("*APIKey"))
+			})
+		})
 	})
 
 	Describe("Tags", func() {
@@ -59,11 +148,29 @@ var _ = Describe("TypeDescriptor", func() {
 			Expect(tags[0].Options).To(ContainElement("oneof=1 2 3"))
 			Expect(tags[0].Options).To(ContainElement("gt=10"))
 			Expect(tags[0].Options).To(ContainElement("lt=20"))
+			Expect(tags[0].Options).To(ContainElement("multipleof=2"))
+			Expect(tags[0].Options).To(ContainElement("regexp=[a-Z]"))
 
 			Expect(tags[1].Key).To(Equal("default"))
 			Expect(tags[1].Name).To(Equal("99.9"))
 		})
 
+		Context("when the pattern has tag separators", func() {
+			It("returns a tag collection successfully", func() {
+				kind := &codedom.TypeDescriptor{
+					Metadata: codedom.Metadata{
+						"pattern": "^(a|b){1,3}`$",
+					},
+				}
+
+				tags := kind.Tags(false)
+				Expect(tags).To(HaveLen(1))
+				Expect(tags[0].Key).To(Equal("validate"))
+				Expect(tags[0].Name).To(Equal("regexp=^(a0x7Cb){10x2C3}\\x60$"))
+				Expect(tags.String()).To(Equal("`validate:\"regexp=^(a0x7Cb){10x2C3}\\\\x60$\"`"))
+			})
+		})
+
 		Context("when the exlusive is disabled", func() {
 			It("returns a tag collection successfully", func() {
 				float64Ptr := func(v float64) *float64 {
@@ -90,8 +197,9 @@ var _ = Describe("TypeDescriptor", func() {
 				Expect(tags[0].Name).To(Equal("required"))
 				Expect(tags[0].Options).To(ContainElement("unique"))
 				Expect(tags[0].Options).To(ContainElement("oneof=1 2 3"))
-				Expect(tags[0].Options).To(ContainElement("gt=10"))
-				Expect(tags[0].Options).To(ContainElement("lt=20"))
+				Expect(tags[0].Options).To(ContainElement("gte=10"))
+				Expect(tags[0].Options).To(ContainElement("lte=20"))
+				Expect(tags[0].Options).To(ContainElement("multipleof=2"))
 
 				Expect(tags[1].Key).To(Equal("default"))
 				Expect(tags[1].Name).To(Equal("99.9"))
@@ -99,6 +207,49 @@ var _ = Describe("TypeDescriptor", func() {
 				Expect(kind.HasProperties()).To(BeFalse())
 			})
 		})
+
+		Context("when the enum is a number", func() {
+			It("returns a tag collection successfully", func() {
+				kind := &codedom.TypeDescriptor{
+					IsEnum: true,
+					Element: &codedom.TypeDescriptor{
+						Name:        "float64",
+						IsPrimitive: true,
+					},
+					Metadata: codedom.Metadata{
+						"values": []interface{}{0.5, 1.5},
+					},
+				}
+
+				tags := kind.Tags(true)
+				Expect(tags).To(HaveLen(1))
+				Expect(tags[0].Key).To(Equal("validate"))
+				Expect(tags[0].Name).To(Equal("required"))
+				Expect(tags[0].Options).To(BeEmpty())
+			})
+		})
+
+		Context("when the exlusive is not set", func() {
+			It("returns a tag collection successfully", func() {
+				float64Ptr := func(v float64) *float64 {
+					return &v
+				}
+
+				kind := &codedom.TypeDescriptor{
+					Metadata: codedom.Metadata{
+						"min":         float64Ptr(0.5),
+						"max":         float64Ptr(1.5),
+						"multiple_of": float64Ptr(0.25),
+					},
+				}
+
+				tags := kind.Tags(false)
+				Expect(tags).To(HaveLen(1))
+				Expect(tags[0].Key).To(Equal("validate"))
+				Expect(tags[0].Name).To(Equal("gte=0.5"))
+				Expect(tags[0].Options).To(ConsistOf("lte=1.5", "multipleof=0.25"))
+			})
+		})
 	})
 })
 
@@ -203,6 +354,58 @@ var _ = Describe("PropertyDescriptor", func() {
 			Expect(tags[5].Name).To(Equal("hello"))
 			Expect(tags[5].Options).To(BeEmpty())
 		})
+
+		Context("when the name is set by x-go-name", func() {
+			It("keeps the name of the tags", func() {
+				property := &codedom.PropertyDescriptor{
+					Name:         "id",
+					PropertyType: &codedom.TypeDescriptor{Name: "string"},
+					Metadata: codedom.Metadata{
+						"go_name": "KeyID",
+					},
+				}
+
+				Expect(property.Identifier()).To(Equal("KeyID"))
+				Expect(property.Tags()[0].Name).To(Equal("id"))
+			})
+		})
+	})
+
+	Describe("Kind", func() {
+		It("returns the kind of the property type", func() {
+			property := &codedom.PropertyDescriptor{
+				Name:         "name",
+				PropertyType: &codedom.TypeDescriptor{Name: "string", IsPrimitive: true, IsNullable: true},
+			}
+
+			Expect(property.Kind()).To(Equal("*string"))
+		})
+
+		Context("when the pointer is set by x-stride-pointer", func() {
+			It("returns the pointer", func() {
+				property := &codedom.PropertyDescriptor{
+					Name:         "name",
+					PropertyType: &codedom.TypeDescriptor{Name: "string", IsPrimitive: true},
+					Metadata: codedom.Metadata{
+						"go_pointer": true,
+					},
+				}
+
+				Expect(property.Kind()).To(Equal("*string"))
+			})
+
+			It("returns the value", func() {
+				property := &codedom.PropertyDescriptor{
+					Name:         "name",
+					PropertyType: &codedom.TypeDescriptor{Name: "string", IsPrimitive: true, IsNullable: true},
+					Metadata: codedom.Metadata{
+						"go_pointer": false,
+					},
+				}
+
+				Expect(property.Kind()).To(Equal("string"))
+			})
+		})
 	})
 })
 
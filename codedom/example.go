@@ -0,0 +1,420 @@
+package codedom
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp/syntax"
+	"strings"
+	"time"
+)
+
+var words = []string{
+	"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel",
+	"india", "juliet", "kilo", "lima", "mike", "november", "oscar", "papa",
+}
+
+// ExampleSynthesizer synthesizes example values from a TypeDescriptor
+type ExampleSynthesizer struct {
+	random *rand.Rand
+}
+
+// NewExampleSynthesizer creates a new synthesizer. The same seed always
+// produces the same sequence of examples.
+func NewExampleSynthesizer(seed int64) *ExampleSynthesizer {
+	return &ExampleSynthesizer{
+		random: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Synthesize returns an example value for the given descriptor
+func (s *ExampleSynthesizer) Synthesize(descriptor *TypeDescriptor) interface{} {
+	switch {
+	case descriptor == nil:
+		return nil
+	case descriptor.Example != nil:
+		return descriptor.Example
+	case descriptor.Default != nil:
+		return descriptor.Default
+	case descriptor.IsAlias:
+		return s.Synthesize(descriptor.Element)
+	case descriptor.IsAny:
+		return nil
+	case descriptor.IsArray:
+		return s.array(descriptor)
+	case descriptor.IsMap:
+		return s.dictionary(descriptor)
+	case descriptor.IsEnum:
+		return s.enum(descriptor)
+	case descriptor.IsUnion:
+		return s.union(descriptor)
+	case descriptor.IsClass:
+		return s.class(descriptor)
+	default:
+		return s.primitive(descriptor)
+	}
+}
+
+func (s *ExampleSynthesizer) class(descriptor *TypeDescriptor) interface{} {
+	item := map[string]interface{}{}
+
+	for _, property := range descriptor.Properties {
+		value := s.Synthesize(property.PropertyType)
+
+		if property.IsEmbedded {
+			if kv, ok := value.(map[string]interface{}); ok {
+				for k, v := range kv {
+					item[k] = v
+				}
+
+				continue
+			}
+		}
+
+		// optional nullable properties are sometimes null
+		if kind := element(property.PropertyType); !property.Required && kind.IsNullable && !kind.IsClass {
+			if s.random.Intn(4) == 0 {
+				value = nil
+			}
+		}
+
+		item[property.Name] = value
+	}
+
+	return item
+}
+
+func (s *ExampleSynthesizer) union(descriptor *TypeDescriptor) interface{} {
+	if len(descriptor.Properties) == 0 {
+		return nil
+	}
+
+	// the properties are the variants of the union
+	variant := descriptor.Properties[s.random.Intn(len(descriptor.Properties))]
+	value := s.Synthesize(variant.PropertyType)
+
+	if item, ok := value.(map[string]interface{}); ok {
+		if key, ok := descriptor.Metadata["discriminator"].(string); ok && key != "" {
+			item[key] = variant.Name
+		}
+	}
+
+	return value
+}
+
+func (s *ExampleSynthesizer) array(descriptor *TypeDescriptor) interface{} {
+	var (
+		count  = s.count(descriptor.Metadata)
+		items  = []interface{}{}
+		unique = false
+		kv     = map[string]bool{}
+	)
+
+	if value, ok := descriptor.Metadata["unique"].(bool); ok {
+		unique = value
+	}
+
+	// give up on uniqueness after a few attempts
+	for attempt := 0; len(items) < count && attempt < count*10; attempt++ {
+		value := s.Synthesize(descriptor.Element)
+
+		if unique {
+			key := fmt.Sprintf("%v", value)
+
+			if _, ok := kv[key]; ok {
+				continue
+			}
+
+			kv[key] = true
+		}
+
+		items = append(items, value)
+	}
+
+	return items
+}
+
+func (s *ExampleSynthesizer) dictionary(descriptor *TypeDescriptor) interface{} {
+	var (
+		count = s.count(descriptor.Metadata)
+		items = map[string]interface{}{}
+	)
+
+	for index := 0; len(items) < count && index < count*10; index++ {
+		key := words[s.random.Intn(len(words))]
+
+		if _, ok := items[key]; ok {
+			key = fmt.Sprintf("%s_%d", key, index)
+		}
+
+		items[key] = s.Synthesize(descriptor.Element)
+	}
+
+	return items
+}
+
+func (s *ExampleSynthesizer) enum(descriptor *TypeDescriptor) interface{} {
+	if values, ok := descriptor.Metadata["values"].([]interface{}); ok {
+		if len(values) > 0 {
+			return values[s.random.Intn(len(values))]
+		}
+	}
+
+	return nil
+}
+
+func (s *ExampleSynthesizer) primitive(descriptor *TypeDescriptor) interface{} {
+	metadata := descriptor.Metadata
+
+	switch descriptor.Name {
+	case "int32", "int64":
+		return int64(s.number(metadata, true))
+	case "float32", "float64":
+		return s.number(metadata, false)
+	case "boolean":
+		return s.random.Intn(2) == 1
+	case "date":
+		return s.time().Format("2006-01-02")
+	case "date-time":
+		return s.time().Format(time.RFC3339)
+	case "uuid":
+		return s.uuid()
+	case "byte":
+		data := make([]byte, 8+s.random.Intn(8))
+		s.random.Read(data)
+		return base64.StdEncoding.EncodeToString(data)
+	default:
+		return s.text(metadata)
+	}
+}
+
+func (s *ExampleSynthesizer) text(metadata Metadata) string {
+	if pattern, ok := metadata["pattern"].(string); ok && pattern != "" {
+		if regex, err := syntax.Parse(pattern, syntax.Perl); err == nil {
+			buffer := &strings.Builder{}
+			s.regex(buffer, regex.Simplify())
+			return buffer.String()
+		}
+	}
+
+	var (
+		min  = 0
+		max  = -1
+		text = words[s.random.Intn(len(words))]
+	)
+
+	if value, ok := metadata["min"].(*float64); ok && value != nil {
+		min = int(*value)
+	}
+
+	if value, ok := metadata["max"].(*float64); ok && value != nil {
+		max = int(*value)
+	}
+
+	for len(text) < min {
+		text = text + words[s.random.Intn(len(words))]
+	}
+
+	if max >= 0 && len(text) > max {
+		text = text[:max]
+	}
+
+	return text
+}
+
+func (s *ExampleSynthesizer) regex(buffer *strings.Builder, regex *syntax.Regexp) {
+	const limit = 8
+
+	repeat := func(min, max int) int {
+		if max < 0 {
+			max = min + limit
+		}
+
+		if max <= min {
+			return min
+		}
+
+		return min + s.random.Intn(max-min+1)
+	}
+
+	switch regex.Op {
+	case syntax.OpLiteral:
+		buffer.WriteString(string(regex.Rune))
+	case syntax.OpCharClass:
+		// the runes are pairs of ranges
+		if count := len(regex.Rune) / 2; count > 0 {
+			var (
+				index = s.random.Intn(count) * 2
+				lo    = regex.Rune[index]
+				hi    = regex.Rune[index+1]
+			)
+
+			// prefer printable ascii characters when the range allows it
+			if lo <= '~' && hi > '~' {
+				hi = '~'
+			}
+
+			if lo < ' ' && hi >= ' ' {
+				lo = ' '
+			}
+
+			buffer.WriteRune(lo + rune(s.random.Intn(int(hi-lo)+1)))
+		}
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		buffer.WriteRune(rune('a' + s.random.Intn(26)))
+	case syntax.OpCapture:
+		s.regex(buffer, regex.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range regex.Sub {
+			s.regex(buffer, sub)
+		}
+	case syntax.OpAlternate:
+		s.regex(buffer, regex.Sub[s.random.Intn(len(regex.Sub))])
+	case syntax.OpStar:
+		for count := repeat(0, -1); count > 0; count-- {
+			s.regex(buffer, regex.Sub[0])
+		}
+	case syntax.OpPlus:
+		for count := repeat(1, -1); count > 0; count-- {
+			s.regex(buffer, regex.Sub[0])
+		}
+	case syntax.OpQuest:
+		for count := repeat(0, 1); count > 0; count-- {
+			s.regex(buffer, regex.Sub[0])
+		}
+	case syntax.OpRepeat:
+		for count := repeat(regex.Min, regex.Max); count > 0; count-- {
+			s.regex(buffer, regex.Sub[0])
+		}
+	}
+}
+
+func (s *ExampleSynthesizer) number(metadata Metadata, integer bool) float64 {
+	var (
+		min      = 0.0
+		max      = 1000.0
+		multiple = 0.0
+	)
+
+	exclusive := func(key string) bool {
+		value, _ := metadata[key].(bool)
+		return value
+	}
+
+	if value, ok := metadata["min"].(*float64); ok && value != nil {
+		min = *value
+
+		if value, ok := metadata["max"].(*float64); !ok || value == nil {
+			max = min + 1000
+		}
+	}
+
+	if value, ok := metadata["max"].(*float64); ok && value != nil {
+		max = *value
+
+		if value, ok := metadata["min"].(*float64); !ok || value == nil {
+			min = math.Min(0, max-1000)
+		}
+	}
+
+	if value, ok := metadata["multiple_of"].(*float64); ok && value != nil && *value > 0 {
+		multiple = *value
+	}
+
+	if integer {
+		min = math.Ceil(min)
+		max = math.Floor(max)
+
+		if exclusive("min_exclusive") {
+			min++
+		}
+
+		if exclusive("max_exclusive") {
+			max--
+		}
+
+		if multiple == 0 {
+			multiple = 1
+		}
+	}
+
+	if multiple > 0 {
+		var (
+			lo = math.Ceil(min / multiple)
+			hi = math.Floor(max / multiple)
+		)
+
+		if exclusive("min_exclusive") && lo*multiple <= min && !integer {
+			lo++
+		}
+
+		if exclusive("max_exclusive") && hi*multiple >= max && !integer {
+			hi--
+		}
+
+		if hi < lo {
+			return lo * multiple
+		}
+
+		return (lo + float64(s.random.Int63n(int64(hi-lo)+1))) * multiple
+	}
+
+	value := min + s.random.Float64()*(max-min)
+	value = math.Round(value*100) / 100
+
+	if exclusive("min_exclusive") && value <= min {
+		value = min + (max-min)/2
+	}
+
+	if exclusive("max_exclusive") && value >= max {
+		value = min + (max-min)/2
+	}
+
+	return value
+}
+
+func (s *ExampleSynthesizer) count(metadata Metadata) int {
+	var (
+		min = 1
+		max = 3
+	)
+
+	if value, ok := metadata["min"].(*float64); ok && value != nil {
+		min = int(*value)
+
+		if max < min {
+			max = min
+		}
+	}
+
+	if value, ok := metadata["max"].(*float64); ok && value != nil {
+		max = int(*value)
+
+		if min > max {
+			min = max
+		}
+	}
+
+	if min == 0 && max > 0 {
+		min = 1
+	}
+
+	return min + s.random.Intn(max-min+1)
+}
+
+func (s *ExampleSynthesizer) time() time.Time {
+	epoch := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return epoch.Add(time.Duration(s.random.Int63n(365*24)) * time.Hour)
+}
+
+func (s *ExampleSynthesizer) uuid() string {
+	data := make([]byte, 16)
+	s.random.Read(data)
+
+	// version 4, variant 10
+	data[6] = (data[6] & 0x0f) | 0x40
+	data[8] = (data[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", data[0:4], data[4:6], data[6:8], data[8:10], data[10:16])
+}
@@ -0,0 +1,242 @@
+package codedom_test
+
+import (
+	"regexp"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+)
+
+var _ = Describe("ExampleSynthesizer", func() {
+	var synthesizer *codedom.ExampleSynthesizer
+
+	float64Ptr := func(v float64) *float64 {
+		return &v
+	}
+
+	BeforeEach(func() {
+		synthesizer = codedom.NewExampleSynthesizer(1)
+	})
+
+	It("synthesizes the same value for the same seed", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:    "account",
+			IsClass: true,
+			Properties: codedom.PropertyDescriptorCollection{
+				&codedom.PropertyDescriptor{
+					Name: "id",
+					PropertyType: &codedom.TypeDescriptor{
+						Name:        "uuid",
+						IsPrimitive: true,
+					},
+				},
+				&codedom.PropertyDescriptor{
+					Name: "name",
+					PropertyType: &codedom.TypeDescriptor{
+						Name:        "string",
+						IsPrimitive: true,
+					},
+				},
+			},
+		}
+
+		value := synthesizer.Synthesize(descriptor)
+		Expect(value).To(HaveKey("id"))
+		Expect(value).To(HaveKey("name"))
+
+		other := codedom.NewExampleSynthesizer(1)
+		Expect(other.Synthesize(descriptor)).To(Equal(value))
+	})
+
+	It("prefers the example of the descriptor", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:        "string",
+			Example:     "jack",
+			Default:     "john",
+			IsPrimitive: true,
+		}
+
+		Expect(synthesizer.Synthesize(descriptor)).To(Equal("jack"))
+	})
+
+	It("uses the default value of the descriptor", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:        "string",
+			Default:     "john",
+			IsPrimitive: true,
+		}
+
+		Expect(synthesizer.Synthesize(descriptor)).To(Equal("john"))
+	})
+
+	It("synthesizes an enum value", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:   "status",
+			IsEnum: true,
+			Metadata: codedom.Metadata{
+				"values": []interface{}{"pending", "completed"},
+			},
+		}
+
+		Expect(synthesizer.Synthesize(descriptor)).To(BeElementOf("pending", "completed"))
+	})
+
+	It("synthesizes a variant of the union", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:    "payment",
+			IsUnion: true,
+			Metadata: codedom.Metadata{
+				"discriminator": "payment_type",
+			},
+			Properties: codedom.PropertyDescriptorCollection{
+				&codedom.PropertyDescriptor{
+					Name: "card",
+					PropertyType: &codedom.TypeDescriptor{
+						Name:    "card-payment",
+						IsClass: true,
+					},
+				},
+			},
+		}
+
+		Expect(synthesizer.Synthesize(descriptor)).To(HaveKeyWithValue("payment_type", "card"))
+	})
+
+	It("synthesizes a value for the alias element", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:    "flag",
+			IsAlias: true,
+			Element: &codedom.TypeDescriptor{
+				Name:        "boolean",
+				IsPrimitive: true,
+			},
+		}
+
+		Expect(synthesizer.Synthesize(descriptor)).To(BeAssignableToTypeOf(true))
+	})
+
+	It("synthesizes an integer within the range", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:        "int32",
+			IsPrimitive: true,
+			Metadata: codedom.Metadata{
+				"min":           float64Ptr(10),
+				"min_exclusive": true,
+				"max":           float64Ptr(20),
+				"max_exclusive": false,
+				"multiple_of":   float64Ptr(3),
+			},
+		}
+
+		for index := 0; index < 20; index++ {
+			value := synthesizer.Synthesize(descriptor).(int64)
+			Expect(value).To(BeNumerically(">", 10))
+			Expect(value).To(BeNumerically("<=", 20))
+			Expect(value % 3).To(BeZero())
+		}
+	})
+
+	It("synthesizes a number within the range", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:        "float64",
+			IsPrimitive: true,
+			Metadata: codedom.Metadata{
+				"min": float64Ptr(-1.5),
+				"max": float64Ptr(1.5),
+			},
+		}
+
+		for index := 0; index < 20; index++ {
+			value := synthesizer.Synthesize(descriptor).(float64)
+			Expect(value).To(BeNumerically(">=", -1.5))
+			Expect(value).To(BeNumerically("<=", 1.5))
+		}
+	})
+
+	It("synthesizes a string that matches the pattern", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:        "string",
+			IsPrimitive: true,
+			Metadata: codedom.Metadata{
+				"pattern": "^[A-Z]{3}-\\d{2,4}(x|y)?$",
+			},
+		}
+
+		pattern := regexp.MustCompile("^[A-Z]{3}-\\d{2,4}(x|y)?$")
+
+		for index := 0; index < 20; index++ {
+			Expect(pattern.MatchString(synthesizer.Synthesize(descriptor).(string))).To(BeTrue())
+		}
+	})
+
+	It("synthesizes a string within the length", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:        "string",
+			IsPrimitive: true,
+			Metadata: codedom.Metadata{
+				"min": float64Ptr(12),
+				"max": float64Ptr(16),
+			},
+		}
+
+		value := synthesizer.Synthesize(descriptor).(string)
+		Expect(len(value)).To(BeNumerically(">=", 12))
+		Expect(len(value)).To(BeNumerically("<=", 16))
+	})
+
+	It("synthesizes the formatted strings", func() {
+		var descriptor *codedom.TypeDescriptor
+
+		descriptor = &codedom.TypeDescriptor{Name: "date-time", IsPrimitive: true}
+		_, err := time.Parse(time.RFC3339, synthesizer.Synthesize(descriptor).(string))
+		Expect(err).To(BeNil())
+
+		descriptor = &codedom.TypeDescriptor{Name: "date", IsPrimitive: true}
+		_, err = time.Parse("2006-01-02", synthesizer.Synthesize(descriptor).(string))
+		Expect(err).To(BeNil())
+
+		descriptor = &codedom.TypeDescriptor{Name: "uuid", IsPrimitive: true}
+		Expect(synthesizer.Synthesize(descriptor)).To(MatchRegexp("^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"))
+	})
+
+	It("synthesizes an array within the size", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:    "names",
+			IsArray: true,
+			Element: &codedom.TypeDescriptor{
+				Name:        "string",
+				IsPrimitive: true,
+			},
+			Metadata: codedom.Metadata{
+				"unique": true,
+				"min":    float64Ptr(2),
+				"max":    float64Ptr(4),
+			},
+		}
+
+		value := synthesizer.Synthesize(descriptor).([]interface{})
+		Expect(len(value)).To(BeNumerically(">=", 2))
+		Expect(len(value)).To(BeNumerically("<=", 4))
+	})
+
+	It("synthesizes a map", func() {
+		descriptor := &codedom.TypeDescriptor{
+			Name:  "labels",
+			IsMap: true,
+			Key: &codedom.TypeDescriptor{
+				Name:        "string",
+				IsPrimitive: true,
+			},
+			Element: &codedom.TypeDescriptor{
+				Name:        "int64",
+				IsPrimitive: true,
+			},
+		}
+
+		value := synthesizer.Synthesize(descriptor).(map[string]interface{})
+		Expect(value).NotTo(BeEmpty())
+	})
+})
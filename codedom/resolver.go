@@ -1,8 +1,11 @@
 package codedom
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strconv"
@@ -16,7 +19,11 @@ import (
 
 // Resolver resolves all swagger spec
 type Resolver struct {
-	Cache    TypeDescriptorMap
+	Cache TypeDescriptorMap
+	// Types maps the primitive types to golang types
+	Types TypeMapping
+	// Names overrides the names of the types, controllers and operations
+	Names    *NameMapping
 	Reporter contract.Reporter
 }
 
@@ -27,10 +34,15 @@ func (r *Resolver) Resolve(swagger *openapi3.Swagger) (*SpecDescriptor, error) {
 
 	defer r.Cache.Clear()
 
+	ctx := &ResolverContext{}
+
+	// the loader does not resolve the references of the callbacks
+	r.dereference(ctx, swagger)
+
 	var (
-		ctx         = &ResolverContext{}
 		components  = swagger.Components
-		controllers = r.operations(ctx, swagger.Paths)
+		schemes     = r.securitySchemes(ctx, components.SecuritySchemes)
+		controllers = r.operations(ctx, swagger.Paths, schemes, swagger.Security)
 	)
 
 	r.schemas(ctx, components.Schemas)
@@ -53,8 +65,9 @@ func (r *Resolver) Resolve(swagger *openapi3.Swagger) (*SpecDescriptor, error) {
 			Description:    swagger.Info.Description,
 			TermsOfService: swagger.Info.TermsOfService,
 		},
-		Types:       r.Cache.Collection(),
-		Controllers: controllers,
+		Types:           r.Cache.Collection(),
+		Controllers:     controllers,
+		SecuritySchemes: schemes,
 	}, nil
 }
 
@@ -78,7 +91,7 @@ func (r *Resolver) schemas(ctx *ResolverContext, schemas map[string]*openapi3.Sc
 	descriptors := TypeDescriptorCollection{}
 
 	for name, schema := range schemas {
-		cctx := ctx.Child(name, schema)
+		cctx := ctx.Child(r.typeOf(name), schema)
 		descriptors = append(descriptors, r.resolve(cctx))
 
 		if err := cctx.Collector; len(err) > 0 {
@@ -89,7 +102,7 @@ func (r *Resolver) schemas(ctx *ResolverContext, schemas map[string]*openapi3.Sc
 	return descriptors
 }
 
-func (r *Resolver) operations(ctx *ResolverContext, operations map[string]*openapi3.PathItem) ControllerDescriptorCollection {
+func (r *Resolver) operations(ctx *ResolverContext, operations map[string]*openapi3.PathItem, schemes SecuritySchemeDescriptorCollection, security openapi3.SecurityRequirements) ControllerDescriptorCollection {
 	var (
 		collector = flaw.ErrorCollector{}
 		reporter  = r.Reporter.With(contract.SeverityHigh)
@@ -106,7 +119,11 @@ func (r *Resolver) operations(ctx *ResolverContext, operations map[string]*opena
 		}
 	}()
 
-	descriptors := ControllerDescriptorMap{}
+	var (
+		descriptors = ControllerDescriptorMap{}
+		// the links refer to the operations by id
+		ids = map[string]*OperationDescriptor{}
+	)
 
 	key := func(tags []string) string {
 		key := "default"
@@ -115,58 +132,41 @@ func (r *Resolver) operations(ctx *ResolverContext, operations map[string]*opena
 			key = tags[0]
 		}
 
-		return key
+		return r.controllerOf(key)
 	}
 
-	for path, spec := range operations {
-		for method, spec := range spec.Operations() {
+	for path, item := range operations {
+		for method, spec := range item.Operations() {
+			// the name overrides apply to the inline types of the operation too
+			name, metadata, err := r.operationName(spec, spec.OperationID)
+
 			r.Reporter.Info("Resolving operation: %s method: %v path: %v...",
-				inflect.Dasherize(spec.OperationID),
+				inflect.Dasherize(name),
 				inflect.UpperCase(method),
 				inflect.LowerCase(path))
 
 			var (
 				controller = descriptors.Get(key(spec.Tags))
-				cctx       = ctx.Child(spec.OperationID, nil)
-			)
-
-			var (
-				parameterMap = make(map[string]*openapi3.ParameterRef)
-				requestMap   = make(map[string]*openapi3.RequestBodyRef)
-				responses    = spec.Responses
+				cctx       = ctx.Child(name, nil)
 			)
 
-			requestMap["request"] = spec.RequestBody
-
-			for _, param := range spec.Parameters {
-				parameterMap[param.Value.Name] = param
-			}
+			// the operation security overrides the top-level one
+			requirements := security
 
-			operation := &OperationDescriptor{
-				Path:        path,
-				Method:      method,
-				Name:        inflect.Dasherize(spec.OperationID),
-				Description: spec.Description,
-				Summary:     spec.Summary,
-				Deprecated:  spec.Deprecated,
-				Tags:        spec.Tags,
-				Requests:    r.requests(cctx, requestMap),
-				Responses:   r.responses(cctx, responses),
+			if spec.Security != nil {
+				requirements = *spec.Security
 			}
 
-			parameters := r.parameters(cctx, parameterMap)
-
-			if len(operation.Requests) == 0 {
-				request := &RequestDescriptor{
-					ContentType: "application/unknown",
-					Description: spec.Description,
-				}
+			operation := r.operation(cctx, name, path, method, item, spec, schemes, requirements)
+			operation.Metadata = metadata
+			operation.Callbacks = r.callbacks(ctx, cctx, name, spec.Callbacks, schemes)
 
-				operation.Requests = append(operation.Requests, request)
+			if id := spec.OperationID; id != "" {
+				ids[id] = operation
 			}
 
-			for _, request := range operation.Requests {
-				request.Parameters = parameters
+			if err != nil {
+				cctx.Collector.Wrap(err)
 			}
 
 			controller.Operations = append(controller.Operations, operation)
@@ -174,19 +174,354 @@ func (r *Resolver) operations(ctx *ResolverContext, operations map[string]*opena
 			if err := cctx.Collector; len(err) > 0 {
 				collector.Wrap(err)
 				r.Reporter.Error("Resolving operation: %s method: %v path: %v fail",
-					inflect.Dasherize(spec.OperationID),
+					inflect.Dasherize(name),
 					inflect.UpperCase(method),
 					inflect.LowerCase(path))
 			} else {
 				r.Reporter.Info("Resolving operation: %s method: %v path: %v successful",
-					inflect.Dasherize(spec.OperationID),
+					inflect.Dasherize(name),
 					inflect.UpperCase(method),
 					inflect.LowerCase(path))
 			}
 		}
 	}
 
-	return descriptors.Collection()
+	controllers := descriptors.Collection()
+	r.navigate(controllers, ids)
+
+	return controllers
+}
+
+func (r *Resolver) operationName(spec *openapi3.Operation, fallback string) (string, Metadata, error) {
+	name := fallback
+
+	if spec.OperationID != "" {
+		name = r.operationOf(spec.OperationID)
+	}
+
+	metadata, err := r.naming("operation: "+inflect.Dasherize(name), spec.ExtensionProps)
+
+	// the name overrides of the config win over the x-go-name extension
+	if spec.OperationID != "" && name != spec.OperationID {
+		delete(metadata, "go_name")
+	}
+
+	if value, ok := metadata["go_name"].(string); ok {
+		name = value
+	}
+
+	return name, metadata, err
+}
+
+func (r *Resolver) operation(ctx *ResolverContext, name, path, method string, item *openapi3.PathItem, spec *openapi3.Operation, schemes SecuritySchemeDescriptorCollection, requirements openapi3.SecurityRequirements) *OperationDescriptor {
+	var (
+		parameterMap = r.merge(item.Parameters, spec.Parameters)
+		requestMap   = make(map[string]*openapi3.RequestBodyRef)
+		responses    = spec.Responses
+	)
+
+	requestMap["request"] = spec.RequestBody
+
+	operation := &OperationDescriptor{
+		Path:        path,
+		Method:      method,
+		Name:        inflect.Dasherize(name),
+		Description: spec.Description,
+		Summary:     spec.Summary,
+		Deprecated:  spec.Deprecated,
+		Tags:        spec.Tags,
+		Requests:    r.requests(ctx, requestMap),
+		Responses:   r.responses(ctx, responses),
+		Security:    r.security(ctx, schemes, requirements),
+	}
+
+	parameters := r.parameters(ctx, parameterMap)
+
+	if len(operation.Requests) == 0 {
+		request := &RequestDescriptor{
+			ContentType: "application/unknown",
+			Description: spec.Description,
+		}
+
+		operation.Requests = append(operation.Requests, request)
+	}
+
+	for _, request := range operation.Requests {
+		request.Parameters = parameters
+	}
+
+	return operation
+}
+
+// merge merges the parameters of the path item with the parameters of the
+// operation. The operation parameters override the path item parameters of
+// the same name and location. The parameters are keyed by name unless the name
+// is used in more than one location.
+func (r *Resolver) merge(shared, parameters openapi3.Parameters) map[string]*openapi3.ParameterRef {
+	var (
+		locations = map[string]*openapi3.ParameterRef{}
+		names     = map[string]int{}
+	)
+
+	key := func(param *openapi3.ParameterRef) string {
+		return strings.ToLower(param.Value.In) + ":" + param.Value.Name
+	}
+
+	for _, items := range []openapi3.Parameters{shared, parameters} {
+		for _, param := range items {
+			if param == nil || param.Value == nil {
+				continue
+			}
+
+			locations[key(param)] = param
+		}
+	}
+
+	for _, param := range locations {
+		names[param.Value.Name]++
+	}
+
+	parameterMap := make(map[string]*openapi3.ParameterRef)
+
+	for _, param := range locations {
+		name := param.Value.Name
+
+		// the location distinguishes the names of the inline parameter types
+		if names[name] > 1 {
+			name = inflect.Dasherize(strings.ToLower(param.Value.In) + "-" + name)
+		}
+
+		parameterMap[name] = param
+	}
+
+	return parameterMap
+}
+
+// dereference resolves the references of the callbacks and the links that the
+// loader does not resolve
+func (r *Resolver) dereference(ctx *ResolverContext, swagger *openapi3.Swagger) {
+	loader := openapi3.NewSwaggerLoader()
+
+	links := func(responses map[string]*openapi3.ResponseRef) {
+		for _, response := range responses {
+			if response.Value == nil {
+				continue
+			}
+
+			for name, link := range response.Value.Links {
+				if link.Value != nil {
+					continue
+				}
+
+				if value, ok := swagger.Components.Links[filepath.Base(link.Ref)]; ok {
+					link.Value = value.Value
+					continue
+				}
+
+				err := fmt.Errorf("Expecting link: %s reference: %s to be defined", name, link.Ref)
+
+				reporter := r.Reporter.With(contract.SeverityVeryHigh)
+				reporter.Error(err.Error())
+
+				ctx.Collector.Wrap(err)
+			}
+		}
+	}
+
+	links(swagger.Components.Responses)
+
+	for _, item := range swagger.Paths {
+		for _, operation := range item.Operations() {
+			links(operation.Responses)
+
+			for name, callback := range operation.Callbacks {
+				if callback.Value == nil {
+					if value, ok := swagger.Components.Callbacks[filepath.Base(callback.Ref)]; ok {
+						callback.Value = value.Value
+					}
+				}
+
+				if callback.Value == nil {
+					err := fmt.Errorf("Expecting callback: %s reference: %s to be defined", inflect.Dasherize(name), callback.Ref)
+
+					reporter := r.Reporter.With(contract.SeverityVeryHigh)
+					reporter.Error(err.Error())
+
+					ctx.Collector.Wrap(err)
+					continue
+				}
+
+				spec := &openapi3.Swagger{
+					Components: swagger.Components,
+					Paths:      openapi3.Paths(*callback.Value),
+				}
+
+				if err := loader.ResolveRefsIn(spec, nil); err != nil {
+					reporter := r.Reporter.With(contract.SeverityVeryHigh)
+					reporter.Error("Resolving callback: %s fail: %v", inflect.Dasherize(name), err)
+
+					ctx.Collector.Wrap(err)
+				}
+
+				for _, item := range spec.Paths {
+					for _, operation := range item.Operations() {
+						links(operation.Responses)
+					}
+				}
+			}
+		}
+	}
+}
+
+// callbacks resolves the callbacks of an operation. The callback operations
+// are named after the operation and the callback unless they have an id. They
+// do not inherit the top-level security requirements.
+func (r *Resolver) callbacks(ctx, octx *ResolverContext, parent string, callbacks map[string]*openapi3.CallbackRef, schemes SecuritySchemeDescriptorCollection) CallbackDescriptorCollection {
+	descriptors := CallbackDescriptorCollection{}
+
+	for key, spec := range callbacks {
+		r.Reporter.Info("Resolving operation: %s callback: %s...",
+			inflect.Dasherize(parent),
+			inflect.Dasherize(key),
+		)
+
+		callback := &CallbackDescriptor{
+			Name: key,
+		}
+
+		for expression, item := range *spec.Value {
+			operations := item.Operations()
+
+			for method, spec := range operations {
+				fallback := parent + "-" + key
+
+				// the operations of the same url are distinguished by the method
+				if len(operations) > 1 {
+					fallback = fallback + "-" + inflect.LowerCase(method)
+				}
+
+				name, metadata, err := r.operationName(spec, fallback)
+
+				var (
+					cctx         = ctx.Child(name, nil)
+					requirements openapi3.SecurityRequirements
+				)
+
+				if spec.Security != nil {
+					requirements = *spec.Security
+				}
+
+				operation := r.operation(cctx, name, expression, method, item, spec, schemes, requirements)
+				operation.Metadata = metadata
+
+				if err != nil {
+					cctx.Collector.Wrap(err)
+				}
+
+				callback.Operations = append(callback.Operations, operation)
+
+				if err := cctx.Collector; len(err) > 0 {
+					octx.Collector.Wrap(err)
+				}
+			}
+		}
+
+		sort.Sort(callback.Operations)
+		descriptors = append(descriptors, callback)
+	}
+
+	sort.Sort(descriptors)
+	return descriptors
+}
+
+func (r *Resolver) securitySchemes(ctx *ResolverContext, schemes map[string]*openapi3.SecuritySchemeRef) SecuritySchemeDescriptorCollection {
+	var (
+		collector = flaw.ErrorCollector{}
+		reporter  = r.Reporter.With(contract.SeverityHigh)
+	)
+
+	reporter.Notice("Resolving security schemes...")
+
+	defer func() {
+		if err := collector; len(err) > 0 {
+			ctx.Collector.Wrap(err)
+			reporter.Error("Resolving security schemes fail")
+		} else {
+			reporter.Success("Resolving security schemes successful")
+		}
+	}()
+
+	descriptors := SecuritySchemeDescriptorCollection{}
+
+	for name, spec := range schemes {
+		r.Reporter.Info("Resolving security scheme: %s...", inflect.Dasherize(name))
+
+		scheme := spec.Value
+
+		descriptor := &SecuritySchemeDescriptor{
+			Name:         name,
+			Description:  scheme.Description,
+			Type:         scheme.Type,
+			Scheme:       inflect.LowerCase(scheme.Scheme),
+			BearerFormat: scheme.BearerFormat,
+		}
+
+		switch scheme.Type {
+		case "apiKey":
+			descriptor.In = scheme.In
+			descriptor.Key = scheme.Name
+		case "http", "oauth2", "openIdConnect":
+		default:
+			err := fmt.Errorf("Expecting security scheme: %s type: %s to be supported",
+				inflect.Dasherize(name),
+				scheme.Type,
+			)
+
+			reporter.Error(err.Error())
+			collector.Wrap(err)
+			continue
+		}
+
+		descriptors = append(descriptors, descriptor)
+
+		r.Reporter.Info("Resolving security scheme: %s successful", inflect.Dasherize(name))
+	}
+
+	sort.Sort(descriptors)
+	return descriptors
+}
+
+func (r *Resolver) security(ctx *ResolverContext, schemes SecuritySchemeDescriptorCollection, requirements openapi3.SecurityRequirements) []SecuritySchemeDescriptorCollection {
+	var descriptors []SecuritySchemeDescriptorCollection
+
+	for _, requirement := range requirements {
+		collection := SecuritySchemeDescriptorCollection{}
+
+		for name, scopes := range requirement {
+			scheme := schemes.find(name)
+
+			if scheme == nil {
+				err := fmt.Errorf("Expecting security scheme: %s to be defined", inflect.Dasherize(name))
+
+				reporter := r.Reporter.With(contract.SeverityVeryHigh)
+				reporter.Error(err.Error())
+				reporter.Error("The security requirements should refer to the security schemes declared in the components")
+
+				ctx.Collector.Wrap(err)
+				continue
+			}
+
+			descriptor := *scheme
+			descriptor.Scopes = scopes
+
+			collection = append(collection, &descriptor)
+		}
+
+		sort.Sort(collection)
+		descriptors = append(descriptors, collection)
+	}
+
+	return descriptors
 }
 
 func (r *Resolver) requests(ctx *ResolverContext, bodies map[string]*openapi3.RequestBodyRef) RequestDescriptorCollection {
@@ -213,7 +548,11 @@ func (r *Resolver) requests(ctx *ResolverContext, bodies map[string]*openapi3.Re
 			continue
 		}
 
-		rcollector := flaw.ErrorCollector{}
+		var (
+			rcollector = flaw.ErrorCollector{}
+			distinct   = r.distinct(spec.Value.Content)
+		)
+
 		r.Reporter.Info("Resolving request body: %s....", inflect.Dasherize(name))
 
 		for contentType, content := range spec.Value.Content {
@@ -232,12 +571,21 @@ func (r *Resolver) requests(ctx *ResolverContext, bodies map[string]*openapi3.Re
 				}
 			}
 
+			key := name
+
+			// the content types with different schemas have a type per content type
+			if distinct {
+				key = name + "-" + mediaOf(contentType)
+			}
+
 			var (
-				cctx       = ctx.Child(name, schema)
+				cctx       = ctx.Child(key, schema)
 				descriptor = &RequestDescriptor{
 					ContentType: contentType,
 					Description: spec.Value.Description,
 					Required:    spec.Value.Required,
+					Example:     exampleOf(content),
+					Encoding:    encodingOf(content),
 					RequestType: r.resolve(cctx),
 				}
 			)
@@ -313,6 +661,7 @@ func (r *Resolver) responses(ctx *ResolverContext, responses map[string]*openapi
 				Code:        code,
 				ContentType: "application/unknown",
 				Description: spec.Value.Description,
+				Links:       r.links(spec.Value.Links),
 				IsDefault:   spec == defaultSpec,
 			}
 
@@ -341,15 +690,16 @@ func (r *Resolver) responses(ctx *ResolverContext, responses map[string]*openapi
 					Code:         code,
 					ContentType:  contentType,
 					Description:  spec.Value.Description,
+					Example:      exampleOf(content),
 					ResponseType: r.resolve(cctx),
 					Parameters:   r.headers(cctx, spec.Value.Headers),
+					Links:        r.links(spec.Value.Links),
 					IsDefault:    spec == defaultSpec,
 				}
 			)
 
-			if length := len(descriptors); code >= 0 && length > 1 {
-				prev := descriptors[length-1]
-
+			// the content types of a response share the body
+			if prev := descriptors.find(code); code >= 0 && prev != nil {
 				if !reflect.DeepEqual(prev.ResponseType, response.ResponseType) {
 					err := fmt.Errorf("Expecting response: %s content-type: %s body: %s to equal content-type: %s body: %s",
 						inflect.Dasherize(text),
@@ -364,13 +714,12 @@ func (r *Resolver) responses(ctx *ResolverContext, responses map[string]*openapi
 					reporter.Error("You cannot have a response with different content-type. The response body should be the same for all content-type declarations")
 
 					cctx.Collector.Wrap(err)
+					continue
 				}
-
-				continue
-			} else {
-				descriptors = append(descriptors, response)
 			}
 
+			descriptors = append(descriptors, response)
+
 			if err := cctx.Collector; len(err) > 0 {
 				reporter.Error("Resolving response: %s content-type: %s fail", inflect.Dasherize(text), inflect.LowerCase(response.ContentType))
 				rcollector.Wrap(err)
@@ -395,6 +744,119 @@ func (r *Resolver) responses(ctx *ResolverContext, responses map[string]*openapi
 	return descriptors
 }
 
+// distinct returns true if the content types do not share the same schema
+func (r *Resolver) distinct(content openapi3.Content) bool {
+	var prev *openapi3.SchemaRef
+
+	for _, media := range content {
+		schema := media.Schema
+
+		switch {
+		case prev == nil:
+			prev = schema
+		case schema == nil:
+			return true
+		case prev.Ref != schema.Ref:
+			return true
+		case prev.Ref == "" && !reflect.DeepEqual(prev.Value, schema.Value):
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Resolver) links(links map[string]*openapi3.LinkRef) LinkDescriptorCollection {
+	descriptors := LinkDescriptorCollection{}
+
+	for name, spec := range links {
+		// the references are resolved beforehand
+		if spec.Value == nil {
+			continue
+		}
+
+		descriptor := &LinkDescriptor{
+			Name:         name,
+			Description:  spec.Value.Description,
+			OperationID:  spec.Value.OperationID,
+			OperationRef: spec.Value.OperationRef,
+			Parameters:   map[string]string{},
+		}
+
+		for key, value := range spec.Value.Parameters {
+			descriptor.Parameters[key] = valueOf(value)
+		}
+
+		if body := spec.Value.RequestBody; body != nil {
+			descriptor.RequestBody = valueOf(body)
+		}
+
+		descriptors = append(descriptors, descriptor)
+	}
+
+	sort.Sort(descriptors)
+	return descriptors
+}
+
+// navigate sets the target operations of the links. The links to unknown
+// operations are removed.
+func (r *Resolver) navigate(controllers ControllerDescriptorCollection, ids map[string]*OperationDescriptor) {
+	var (
+		reporter = r.Reporter.With(contract.SeverityLow)
+		targets  = map[*OperationDescriptor]string{}
+		paths    = map[string]*OperationDescriptor{}
+	)
+
+	for _, controller := range controllers {
+		for _, operation := range controller.Operations {
+			targets[operation] = controller.Name
+			paths[inflect.UpperCase(operation.Method)+" "+operation.Path] = operation
+		}
+	}
+
+	resolve := func(operation *OperationDescriptor) {
+		for _, response := range operation.Responses {
+			links := LinkDescriptorCollection{}
+
+			for _, link := range response.Links {
+				target := ids[link.OperationID]
+
+				if reference := link.OperationRef; reference != "" {
+					target = paths[operationOfRef(reference)]
+				}
+
+				if target == nil {
+					reporter.Warn("Resolving operation: %s link: %s skipped. The target operation is not found",
+						inflect.Dasherize(operation.Name),
+						link.Name,
+					)
+
+					continue
+				}
+
+				link.Operation = target
+				link.Controller = targets[target]
+
+				links = append(links, link)
+			}
+
+			response.Links = links
+		}
+	}
+
+	for _, controller := range controllers {
+		for _, operation := range controller.Operations {
+			resolve(operation)
+
+			for _, callback := range operation.Callbacks {
+				for _, operation := range callback.Operations {
+					resolve(operation)
+				}
+			}
+		}
+	}
+}
+
 func (r *Resolver) responsesOf(responses map[string]*openapi3.ResponseRef) *openapi3.ResponseRef {
 	if spec, ok := responses["default"]; ok {
 		return spec
@@ -498,6 +960,13 @@ func (r *Resolver) parameters(ctx *ResolverContext, parameters map[string]*opena
 			parameter.Explode = *value
 		}
 
+		metadata, err := r.naming("parameter: "+inflect.Dasherize(name), spec.Value.ExtensionProps)
+		if err != nil {
+			cctx.Collector.Wrap(err)
+		}
+
+		parameter.Metadata = metadata
+
 		descriptors = append(descriptors, parameter)
 
 		if err := cctx.Collector; len(err) > 0 {
@@ -556,6 +1025,13 @@ func (r *Resolver) headers(ctx *ResolverContext, headers map[string]*openapi3.He
 			}
 		)
 
+		metadata, err := r.naming("header: "+inflect.Dasherize(name), spec.Value.ExtensionProps)
+		if err != nil {
+			cctx.Collector.Wrap(err)
+		}
+
+		header.Metadata = metadata
+
 		descriptors = append(descriptors, header)
 
 		if err := cctx.Collector; len(err) > 0 {
@@ -572,7 +1048,23 @@ func (r *Resolver) headers(ctx *ResolverContext, headers map[string]*openapi3.He
 	return descriptors
 }
 
-func (r *Resolver) add(descriptor *TypeDescriptor) error {
+func (r *Resolver) add(ctx *ResolverContext, descriptor *TypeDescriptor) error {
+	// the x-go-name extension of a named schema renames the golang type
+	if ctx.Schema != nil && ctx.Schema.Ref == "" && ctx.Parent.IsRoot() {
+		metadata, err := r.naming("type: "+inflect.Dasherize(ctx.Name), ctx.Schema.Value.ExtensionProps)
+		if err != nil {
+			return err
+		}
+
+		if name, ok := metadata["go_name"]; ok {
+			if descriptor.Metadata == nil {
+				descriptor.Metadata = Metadata{}
+			}
+
+			descriptor.Metadata["go_name"] = name
+		}
+	}
+
 	if err := r.Cache.Add(descriptor); err != nil {
 		reporter := r.Reporter.With(contract.SeverityVeryHigh)
 		reporter.Error("Resolving type: %s fail: %v ", inflect.Dasherize(descriptor.Name), err)
@@ -603,18 +1095,18 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 
 	switch {
 	case ctx.Schema == nil:
-	case ctx.Schema.Value.OneOf != nil:
-		reporter.Warn("Resolving type: %s does not support 'one-of' clause. Reverting to generic type", inflect.Dasherize(ctx.Name))
+	case ctx.Schema.Value.OneOf != nil && ctx.Schema.Value.Discriminator == nil:
+		reporter.Warn("Resolving type: %s does not support 'one-of' clause without discriminator. Reverting to generic type", inflect.Dasherize(ctx.Name))
 		ctx.Schema = nil
-	case ctx.Schema.Value.AnyOf != nil:
-		reporter.Warn("Resolving type: %s does not support 'any-of' clause. Reverting to generic type", inflect.Dasherize(ctx.Name))
-		ctx.Schema = nil
-	case ctx.Schema.Value.AllOf != nil:
-		reporter.Warn("Resolving type: %s does not support 'all-of' clause. Reverting to generic type", inflect.Dasherize(ctx.Name))
+	case ctx.Schema.Value.AnyOf != nil && ctx.Schema.Value.Discriminator == nil:
+		reporter.Warn("Resolving type: %s does not support 'any-of' clause without discriminator. Reverting to generic type", inflect.Dasherize(ctx.Name))
 		ctx.Schema = nil
 	case ctx.Schema.Value.Not != nil:
 		reporter.Warn("Resolving type: %s does not support 'not' clause. Reverting to generic type", inflect.Dasherize(ctx.Name))
 		ctx.Schema = nil
+	case ctx.Schema.Ref == "" && ctx.Schema.Value.AllOf != nil && r.aliasOf(ctx.Schema.Value) == nil && !r.composable(ctx.Schema.Value, map[*openapi3.Schema]bool{}):
+		reporter.Warn("Resolving type: %s does not support 'all-of' clause of non-object types. Reverting to generic type", inflect.Dasherize(ctx.Name))
+		ctx.Schema = nil
 	}
 
 	if ctx.Schema == nil {
@@ -630,7 +1122,7 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 			}
 
 			// add the descriptor to the cache
-			if err := r.add(descriptor); err != nil {
+			if err := r.add(ctx, descriptor); err != nil {
 				collector.Wrap(err)
 			}
 		}
@@ -638,12 +1130,73 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 		return descriptor
 	}
 
+	// the x-go-type extension replaces the generated type. The named
+	// references are resolved to aliases below.
+	if ctx.Schema.Ref == "" || !ctx.Parent.IsRoot() {
+		descriptor, err := r.extension(inflect.Dasherize(ctx.Name), ctx.Schema.Value)
+
+		switch {
+		case err != nil:
+			collector.Wrap(err)
+			return &TypeDescriptor{IsAny: true}
+		case descriptor != nil && ctx.Parent.IsRoot():
+			descriptor = &TypeDescriptor{
+				Name:        inflect.Dasherize(ctx.Name),
+				Description: ctx.Schema.Value.Description,
+				IsAlias:     true,
+				Element:     descriptor,
+			}
+
+			// add the descriptor to the cache
+			if err := r.add(ctx, descriptor); err != nil {
+				collector.Wrap(err)
+			}
+
+			return descriptor
+		case descriptor != nil:
+			return descriptor
+		}
+	}
+
 	// reference type descriptor
 	if reference := ctx.Schema.Ref; reference != "" {
 		reporter.Info("Resolving type: %s to alias...", inflect.Dasherize(ctx.Name))
 
 		var (
-			cctx       = ctx.Dereference()
+			cctx       = ctx.Dereference(r.typeOf)
+			descriptor = r.resolve(cctx)
+		)
+
+		if ctx.Parent.IsRoot() {
+			descriptor = &TypeDescriptor{
+				Name:        inflect.Dasherize(ctx.Name),
+				Description: ctx.Schema.Value.Description,
+				IsAlias:     true,
+				Element:     descriptor,
+			}
+
+			// add the descriptor to the cache
+			if err := r.add(ctx, descriptor); err != nil {
+				cctx.Collector.Wrap(err)
+			}
+		}
+
+		if err := cctx.Collector; len(err) > 0 {
+			collector.Wrap(err)
+			reporter.Error("Resolving type: %s to alias fail", inflect.Dasherize(ctx.Name))
+		} else {
+			reporter.Info("Resolving type: %s to alias successful", inflect.Dasherize(ctx.Name))
+		}
+
+		return descriptor
+	}
+
+	// the all-of clause of a single reference describes the referenced type
+	if schema := r.aliasOf(ctx.Schema.Value); schema != nil {
+		reporter.Info("Resolving type: %s to alias...", inflect.Dasherize(ctx.Name))
+
+		var (
+			cctx       = ctx.Child("", schema).Dereference(r.typeOf)
 			descriptor = r.resolve(cctx)
 		)
 
@@ -656,7 +1209,7 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 			}
 
 			// add the descriptor to the cache
-			if err := r.add(descriptor); err != nil {
+			if err := r.add(ctx, descriptor); err != nil {
 				cctx.Collector.Wrap(err)
 			}
 		}
@@ -671,6 +1224,102 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 		return descriptor
 	}
 
+	// union type descriptor
+	if variants := r.variants(ctx.Schema.Value); len(variants) > 0 {
+		reporter.Info("Resolving type: %s to union...", inflect.Dasherize(ctx.Name))
+
+		var (
+			discriminator = ctx.Schema.Value.Discriminator
+			mapping       = map[string]string{}
+		)
+
+		descriptor := &TypeDescriptor{
+			Name:        inflect.Dasherize(ctx.Name),
+			Description: ctx.Schema.Value.Description,
+			Example:     ctx.Schema.Value.Example,
+			IsUnion:     true,
+			IsNullable:  true,
+			Metadata: Metadata{
+				"discriminator": discriminator.PropertyName,
+			},
+		}
+
+		for value, reference := range discriminator.Mapping {
+			mapping[filepath.Base(reference)] = value
+		}
+
+		for _, schema := range variants {
+			if schema.Ref == "" {
+				err := fmt.Errorf("Expecting type: %s variant to be a reference", inflect.Dasherize(ctx.Name))
+
+				reporter := r.Reporter.With(contract.SeverityVeryHigh)
+				reporter.Error(err.Error())
+				reporter.Error("The 'one-of' and 'any-of' clauses with discriminator support only '$ref' variants")
+
+				collector.Wrap(err)
+				continue
+			}
+
+			name := filepath.Base(schema.Ref)
+
+			reporter.Info("Resolving type: %s variant: %s...",
+				inflect.Dasherize(ctx.Name),
+				inflect.Dasherize(name))
+
+			// the schema name is the implicit discriminator value
+			value, ok := mapping[name]
+			if !ok {
+				value = name
+			}
+
+			var (
+				cctx     = ctx.Child("", schema)
+				property = &PropertyDescriptor{
+					Name:         value,
+					Required:     true,
+					PropertyType: r.resolve(cctx),
+				}
+			)
+
+			if !property.PropertyType.IsClass {
+				err := fmt.Errorf("Expecting type: %s variant: %s to be an object",
+					inflect.Dasherize(ctx.Name),
+					inflect.Dasherize(name))
+
+				reporter := r.Reporter.With(contract.SeverityVeryHigh)
+				reporter.Error(err.Error())
+
+				cctx.Collector.Wrap(err)
+			}
+
+			descriptor.Properties = append(descriptor.Properties, property)
+
+			if err := cctx.Collector; len(err) > 0 {
+				collector.Wrap(err)
+				reporter.Error("Resolving type: %s variant: %s fail",
+					inflect.Dasherize(ctx.Name),
+					inflect.Dasherize(name))
+			} else {
+				reporter.Info("Resolving type: %s variant: %s successful",
+					inflect.Dasherize(ctx.Name),
+					inflect.Dasherize(name))
+			}
+		}
+
+		// add the descriptor to the cache
+		if err := r.add(ctx, descriptor); err != nil {
+			collector.Wrap(err)
+		}
+
+		if err := collector; len(err) > 0 {
+			reporter.Error("Resolving type: %s to union fail", inflect.Dasherize(ctx.Name))
+		} else {
+			reporter.Info("Resolving type: %s to union successful", inflect.Dasherize(ctx.Name))
+		}
+
+		return descriptor
+	}
+
 	// class type descriptor
 	if kind := r.kind(ctx.Schema.Value); kind == "object" {
 		reporter.Info("Resolving type: %s to class...", inflect.Dasherize(ctx.Name))
@@ -678,25 +1327,65 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 		descriptor := &TypeDescriptor{
 			Name:        inflect.Dasherize(ctx.Name),
 			Description: ctx.Schema.Value.Description,
+			Example:     ctx.Schema.Value.Example,
 			IsClass:     true,
 			IsNullable:  true,
 		}
 
 		//TODO: handle min and max properties somehow
 		//TODO: handle pattern properties
-		//TODO: handle discriminator
 
-		required := func(name string) bool {
-			for _, key := range ctx.Schema.Value.Required {
-				if strings.EqualFold(key, name) {
-					return true
+		composite := &composition{
+			Properties: map[string]*openapi3.SchemaRef{},
+			Required:   map[string]bool{},
+		}
+
+		// flatten the all-of clause
+		if err := r.compose(ctx, ctx.Schema.Value, composite); err != nil {
+			collector.Wrap(err)
+		}
+
+		for _, schema := range composite.Embedded {
+			reporter.Info("Resolving type: %s embedded: %s...",
+				inflect.Dasherize(ctx.Name),
+				inflect.Dasherize(filepath.Base(schema.Ref)))
+
+			var (
+				cctx     = ctx.Child("", schema)
+				property = &PropertyDescriptor{
+					Name:         inflect.Dasherize(filepath.Base(schema.Ref)),
+					Required:     true,
+					IsEmbedded:   true,
+					PropertyType: r.resolve(cctx),
 				}
+			)
+
+			if !property.PropertyType.IsClass {
+				err := fmt.Errorf("Expecting type: %s embedded: %s to be an object",
+					inflect.Dasherize(ctx.Name),
+					inflect.Dasherize(property.Name))
+
+				reporter := r.Reporter.With(contract.SeverityVeryHigh)
+				reporter.Error(err.Error())
+
+				cctx.Collector.Wrap(err)
 			}
 
-			return false
+			descriptor.Properties = append(descriptor.Properties, property)
+
+			if err := cctx.Collector; len(err) > 0 {
+				collector.Wrap(err)
+				reporter.Error("Resolving type: %s embedded: %s fail",
+					inflect.Dasherize(ctx.Name),
+					inflect.Dasherize(property.Name))
+			} else {
+				reporter.Info("Resolving type: %s embedded: %s successful",
+					inflect.Dasherize(ctx.Name),
+					inflect.Dasherize(property.Name))
+			}
 		}
 
-		for field, schema := range ctx.Schema.Value.Properties {
+		for field, schema := range composite.Properties {
 			reporter.Info("Resolving type: %s field: %s...",
 				inflect.Dasherize(ctx.Name),
 				inflect.Dasherize(field))
@@ -708,13 +1397,30 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 					Description:  schema.Value.Description,
 					ReadOnly:     schema.Value.ReadOnly,
 					WriteOnly:    schema.Value.WriteOnly,
-					Required:     required(field),
+					Required:     composite.Required[strings.ToLower(field)],
 					PropertyType: r.resolve(cctx),
 				}
 			)
 
+			// the extensions of a reference belong to the referenced schema
+			if schema.Ref == "" {
+				subject := fmt.Sprintf("type: %s field: %s", inflect.Dasherize(ctx.Name), inflect.Dasherize(field))
+
+				metadata, err := r.naming(subject, schema.Value.ExtensionProps)
+				if err != nil {
+					cctx.Collector.Wrap(err)
+				}
+
+				property.Metadata = metadata
+			}
+
 			descriptor.Properties = append(descriptor.Properties, property)
 
+			// the embedded types should not redeclare the property with another type
+			if err := r.conflict(ctx, descriptor, property); err != nil {
+				cctx.Collector.Wrap(err)
+			}
+
 			if err := cctx.Collector; len(err) > 0 {
 				collector.Wrap(err)
 				reporter.Error("Resolving type: %s field: %s fail",
@@ -793,7 +1499,7 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 		sort.Sort(descriptor.Properties)
 
 		// add the descriptor to the cache
-		if err := r.add(descriptor); err != nil {
+		if err := r.add(ctx, descriptor); err != nil {
 			collector.Wrap(err)
 		}
 
@@ -815,15 +1521,16 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 			Name:        inflect.Dasherize(ctx.Name),
 			Description: ctx.Schema.Value.Description,
 			Default:     ctx.Schema.Value.Default,
+			Example:     ctx.Schema.Value.Example,
 			IsNullable:  ctx.Schema.Value.Nullable,
 			IsArray:     true,
 			Element:     r.resolve(cctx),
 			Metadata: Metadata{
 				"unique":        ctx.Schema.Value.UniqueItems,
-				"min":           uint64Ptr(&ctx.Schema.Value.MinLength),
-				"max":           uint64Ptr(ctx.Schema.Value.MaxLength),
-				"min_exclusive": ctx.Schema.Value.ExclusiveMin,
-				"max_exclusive": ctx.Schema.Value.ExclusiveMax,
+				"min":           uint64Ptr(&ctx.Schema.Value.MinItems),
+				"max":           uint64Ptr(ctx.Schema.Value.MaxItems),
+				"min_exclusive": false,
+				"max_exclusive": false,
 			},
 		}
 
@@ -832,7 +1539,7 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 		}
 
 		// add the descriptor to the cache
-		if err := r.add(descriptor); err != nil {
+		if err := r.add(ctx, descriptor); err != nil {
 			collector.Wrap(err)
 		}
 
@@ -846,7 +1553,8 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 	}
 
 	// enum type descriptor
-	if kind := r.kind(ctx.Schema.Value); kind == "string" {
+	switch kind := r.kind(ctx.Schema.Value); kind {
+	case "string", "int32", "int64", "float32", "float64":
 		if values := ctx.Schema.Value.Enum; len(values) > 0 {
 			reporter.Info("Resolving type: %s to enum...", inflect.Dasherize(ctx.Name))
 
@@ -854,15 +1562,20 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 				Name:        inflect.Dasherize(ctx.Name),
 				Description: ctx.Schema.Value.Description,
 				Default:     ctx.Schema.Value.Default,
+				Example:     ctx.Schema.Value.Example,
 				IsNullable:  ctx.Schema.Value.Nullable,
 				IsEnum:      true,
+				Element: &TypeDescriptor{
+					Name:        kind,
+					IsPrimitive: true,
+				},
 				Metadata: Metadata{
 					"values": values,
 				},
 			}
 
 			// add the descriptor to the cache
-			if err := r.add(descriptor); err != nil {
+			if err := r.add(ctx, descriptor); err != nil {
 				collector.Wrap(err)
 			}
 
@@ -881,18 +1594,20 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 	descriptor := &TypeDescriptor{
 		Name:        r.kind(ctx.Schema.Value),
 		Default:     ctx.Schema.Value.Default,
+		Example:     ctx.Schema.Value.Example,
 		IsNullable:  ctx.Schema.Value.Nullable,
 		IsPrimitive: true,
 	}
 
+	// the binary type is a file that does not have length constraints
 	switch r.kind(ctx.Schema.Value) {
-	case "string", "byte", "binary":
+	case "string", "byte":
 		descriptor.Metadata = Metadata{
 			"min":           uint64Ptr(&ctx.Schema.Value.MinLength),
 			"max":           uint64Ptr(ctx.Schema.Value.MaxLength),
 			"pattern":       ctx.Schema.Value.Pattern,
-			"min_exclusive": ctx.Schema.Value.ExclusiveMin,
-			"max_exclusive": ctx.Schema.Value.ExclusiveMax,
+			"min_exclusive": false,
+			"max_exclusive": false,
 		}
 	case "int32", "int64", "float32", "float64":
 		descriptor.Metadata = Metadata{
@@ -904,6 +1619,13 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 		}
 	}
 
+	// the constraints cannot be validated for the mapped types
+	if kind, ok := r.Types.Lookup(ctx.Schema.Value); ok {
+		descriptor.Metadata = Metadata{
+			"go_type": kind,
+		}
+	}
+
 	if ctx.Parent.IsRoot() {
 		descriptor = &TypeDescriptor{
 			Name:        ctx.Name,
@@ -913,7 +1635,7 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 		}
 
 		// add the descriptor to the cache
-		if err := r.add(descriptor); err != nil {
+		if err := r.add(ctx, descriptor); err != nil {
 			collector.Wrap(err)
 		}
 	}
@@ -927,6 +1649,171 @@ func (r *Resolver) resolve(ctx *ResolverContext) *TypeDescriptor {
 	return descriptor
 }
 
+func (r *Resolver) variants(schema *openapi3.Schema) []*openapi3.SchemaRef {
+	if schema.Discriminator == nil {
+		return nil
+	}
+
+	if len(schema.OneOf) > 0 {
+		return schema.OneOf
+	}
+
+	return schema.AnyOf
+}
+
+// aliasOf returns the reference of an all-of clause that only describes a
+// referenced type, which is not an object. The other members of the clause
+// cannot declare properties.
+func (r *Resolver) aliasOf(schema *openapi3.Schema) *openapi3.SchemaRef {
+	var reference *openapi3.SchemaRef
+
+	if len(schema.AllOf) == 0 || len(schema.Properties) > 0 {
+		return nil
+	}
+
+	for _, item := range schema.AllOf {
+		switch {
+		case item.Ref == "" && len(item.Value.Properties) == 0 && len(item.Value.AllOf) == 0:
+			continue
+		case item.Ref == "" || reference != nil:
+			return nil
+		}
+
+		reference = item
+	}
+
+	if reference == nil || r.isClass(reference.Value, map[*openapi3.Schema]bool{}) {
+		return nil
+	}
+
+	return reference
+}
+
+// composable returns true if the members of the all-of clause can be
+// flattened into a class
+func (r *Resolver) composable(schema *openapi3.Schema, visited map[*openapi3.Schema]bool) bool {
+	for _, item := range schema.AllOf {
+		switch {
+		case item.Ref != "":
+			if !r.isClass(item.Value, visited) {
+				return false
+			}
+		case r.kind(item.Value) != "object" || item.Value.OneOf != nil || item.Value.AnyOf != nil:
+			return false
+		case !r.composable(item.Value, visited):
+			return false
+		}
+	}
+
+	return true
+}
+
+// isClass returns true if the schema is resolved to a class
+func (r *Resolver) isClass(schema *openapi3.Schema, visited map[*openapi3.Schema]bool) bool {
+	// the recursive compositions are checked once
+	if visited[schema] {
+		return true
+	}
+
+	visited[schema] = true
+
+	if _, ok := schema.Extensions["x-go-type"]; ok {
+		return false
+	}
+
+	// the unions and the generic types are not classes
+	if r.kind(schema) != "object" || schema.OneOf != nil || schema.AnyOf != nil || schema.Not != nil {
+		return false
+	}
+
+	if len(schema.AllOf) > 0 {
+		return r.composable(schema, visited)
+	}
+
+	return len(schema.Properties) > 0
+}
+
+func (r *Resolver) compose(ctx *ResolverContext, schema *openapi3.Schema, composite *composition) error {
+	collector := flaw.ErrorCollector{}
+
+	for _, key := range schema.Required {
+		composite.Required[strings.ToLower(key)] = true
+	}
+
+	for field, property := range schema.Properties {
+		if prev, ok := composite.Properties[field]; ok {
+			if prev.Ref != property.Ref || r.kind(prev.Value) != r.kind(property.Value) {
+				err := fmt.Errorf("Expecting type: %s field: %s to have a single type declaration",
+					inflect.Dasherize(ctx.Name),
+					inflect.Dasherize(field))
+
+				reporter := r.Reporter.With(contract.SeverityVeryHigh)
+				reporter.Error(err.Error())
+				reporter.Error("The 'all-of' clause cannot declare the same property with different types")
+
+				collector.Wrap(err)
+				continue
+			}
+		}
+
+		composite.Properties[field] = property
+	}
+
+	for _, item := range schema.AllOf {
+		switch {
+		case item.Ref != "":
+			composite.Embedded = append(composite.Embedded, item)
+		case r.kind(item.Value) == "object":
+			if err := r.compose(ctx, item.Value, composite); err != nil {
+				collector.Wrap(err)
+			}
+		default:
+			err := fmt.Errorf("Expecting type: %s 'all-of' clause to contain only objects", inflect.Dasherize(ctx.Name))
+
+			reporter := r.Reporter.With(contract.SeverityVeryHigh)
+			reporter.Error(err.Error())
+
+			collector.Wrap(err)
+		}
+	}
+
+	if len(collector) > 0 {
+		return collector
+	}
+
+	return nil
+}
+
+func (r *Resolver) conflict(ctx *ResolverContext, descriptor *TypeDescriptor, property *PropertyDescriptor) error {
+	for _, embedded := range descriptor.Properties {
+		if !embedded.IsEmbedded || !embedded.PropertyType.IsClass {
+			continue
+		}
+
+		for _, field := range embedded.PropertyType.Properties {
+			if field.Name != property.Name {
+				continue
+			}
+
+			if field.PropertyType.Kind() != property.PropertyType.Kind() {
+				err := fmt.Errorf("Expecting type: %s field: %s to have the same type as embedded: %s field: %s",
+					inflect.Dasherize(ctx.Name),
+					inflect.Dasherize(property.Name),
+					inflect.Dasherize(embedded.Name),
+					inflect.Dasherize(field.Name))
+
+				reporter := r.Reporter.With(contract.SeverityVeryHigh)
+				reporter.Error(err.Error())
+				reporter.Error("The 'all-of' clause cannot declare the same property with different types")
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (r *Resolver) kind(schema *openapi3.Schema) string {
 	var (
 		kind   = schema.Type
@@ -976,6 +1863,128 @@ func (r *Resolver) kind(schema *openapi3.Schema) string {
 	}
 }
 
+// mediaOf returns a short name of the content type such as json, xml, form,
+// multipart, text or binary
+func mediaOf(contentType string) string {
+	media := strings.ToLower(contentType)
+
+	if index := strings.Index(media, ";"); index >= 0 {
+		media = strings.TrimSpace(media[:index])
+	}
+
+	switch {
+	case media == "application/x-www-form-urlencoded":
+		return "form"
+	case media == "multipart/form-data":
+		return "multipart"
+	case media == "application/octet-stream":
+		return "binary"
+	case strings.HasSuffix(media, "json"):
+		return "json"
+	case strings.HasSuffix(media, "xml"):
+		return "xml"
+	case strings.HasPrefix(media, "text/"):
+		return "text"
+	}
+
+	if index := strings.LastIndexAny(media, "/+"); index >= 0 {
+		media = media[index+1:]
+	}
+
+	return inflect.Dasherize(media)
+}
+
+// operationOfRef returns the method and the path of an operation reference
+// such as #/paths/~1users~1{userId}/get
+func operationOfRef(reference string) string {
+	const prefix = "#/paths/"
+
+	if !strings.HasPrefix(reference, prefix) {
+		return ""
+	}
+
+	reference = strings.TrimPrefix(reference, prefix)
+
+	index := strings.LastIndex(reference, "/")
+	if index < 0 {
+		return ""
+	}
+
+	path := reference[:index]
+
+	if value, err := url.PathUnescape(path); err == nil {
+		path = value
+	}
+
+	path = strings.Replace(path, "~1", "/", -1)
+	path = strings.Replace(path, "~0", "~", -1)
+
+	return inflect.UpperCase(reference[index+1:]) + " " + path
+}
+
+// valueOf returns the text of a link parameter. The constants that are not
+// strings are JSON encoded.
+func valueOf(value interface{}) string {
+	if text, ok := value.(string); ok {
+		return text
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(data)
+}
+
+func encodingOf(content *openapi3.MediaType) map[string]string {
+	var encoding map[string]string
+
+	for name, spec := range content.Encoding {
+		if spec == nil || spec.ContentType == "" {
+			continue
+		}
+
+		if encoding == nil {
+			encoding = map[string]string{}
+		}
+
+		encoding[name] = spec.ContentType
+	}
+
+	return encoding
+}
+
+func exampleOf(content *openapi3.MediaType) interface{} {
+	if content.Example != nil {
+		return content.Example
+	}
+
+	names := []string{}
+
+	for name := range content.Examples {
+		names = append(names, name)
+	}
+
+	// pick the first example by name
+	sort.Strings(names)
+
+	for _, name := range names {
+		if example := content.Examples[name]; example != nil && example.Value != nil {
+			return example.Value.Value
+		}
+	}
+
+	return nil
+}
+
+// composition represents a flattened 'all-of' clause
+type composition struct {
+	Properties map[string]*openapi3.SchemaRef
+	Required   map[string]bool
+	Embedded   []*openapi3.SchemaRef
+}
+
 func uint64Ptr(v *uint64) *float64 {
 	if v == nil {
 		return nil
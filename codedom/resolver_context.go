@@ -33,10 +33,11 @@ func (r *ResolverContext) Child(name string, schema *openapi3.SchemaRef) *Resolv
 	return ctx
 }
 
-// Dereference returns the dereferenced context
-func (r *ResolverContext) Dereference() *ResolverContext {
+// Dereference returns the dereferenced context. The name function returns
+// the name of the referenced type.
+func (r *ResolverContext) Dereference(name func(string) string) *ResolverContext {
 	ctx := &ResolverContext{
-		Name:   inflect.Dasherize(filepath.Base(r.Schema.Ref)),
+		Name:   inflect.Dasherize(name(filepath.Base(r.Schema.Ref))),
 		Schema: &openapi3.SchemaRef{Value: r.Schema.Value},
 		Parent: &ResolverContext{},
 	}
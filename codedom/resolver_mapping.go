@@ -0,0 +1,193 @@
+package codedom
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/phogolabs/stride/inflect"
+)
+
+// TypeMapping maps the primitive types in the form <type>[/<format>] to
+// golang types in the form [<import-path>.]<name>
+type TypeMapping map[string]string
+
+// Lookup returns the golang type of the schema
+func (m TypeMapping) Lookup(schema *openapi3.Schema) (string, bool) {
+	if schema.Format != "" {
+		if kind, ok := m[schema.Type+"/"+schema.Format]; ok {
+			return kind, true
+		}
+	}
+
+	kind, ok := m[schema.Type]
+	return kind, ok
+}
+
+// TypeImport is the value of the x-go-type-import extension
+type TypeImport struct {
+	Path string `json:"path"`
+	Name string `json:"name"`
+}
+
+// UnmarshalJSON unmarshals the import path or the import object
+func (t *TypeImport) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &t.Path); err == nil {
+		return nil
+	}
+
+	type object TypeImport
+	return json.Unmarshal(data, (*object)(t))
+}
+
+// NameMapping overrides the names of the resolved descriptors
+type NameMapping struct {
+	Types       map[string]string
+	Controllers map[string]string
+	Operations  map[string]string
+}
+
+func (r *Resolver) nameOf(names map[string]string, name string) string {
+	if value, ok := names[name]; ok {
+		return value
+	}
+
+	keys := []string{}
+
+	for key := range names {
+		keys = append(keys, key)
+	}
+
+	// the keys are sorted, because more than one of them could match the name
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if inflect.Dasherize(key) == inflect.Dasherize(name) {
+			return names[key]
+		}
+	}
+
+	return name
+}
+
+func (r *Resolver) typeOf(name string) string {
+	if r.Names == nil {
+		return name
+	}
+
+	return r.nameOf(r.Names.Types, name)
+}
+
+func (r *Resolver) controllerOf(name string) string {
+	if r.Names == nil {
+		return name
+	}
+
+	return r.nameOf(r.Names.Controllers, name)
+}
+
+func (r *Resolver) operationOf(name string) string {
+	if r.Names == nil {
+		return name
+	}
+
+	return r.nameOf(r.Names.Operations, name)
+}
+
+// extension returns the primitive type set by the x-go-type extension. It
+// returns nil if the schema does not have the extension.
+func (r *Resolver) extension(name string, schema *openapi3.Schema) (*TypeDescriptor, error) {
+	var (
+		kind   string
+		target = &TypeImport{}
+	)
+
+	if ok, err := r.unmarshal(schema.ExtensionProps, "x-go-type", &kind); !ok || err != nil {
+		if err != nil {
+			err = fmt.Errorf("Expecting type: %s extension: x-go-type to be a string", name)
+		}
+
+		return nil, err
+	}
+
+	if _, err := r.unmarshal(schema.ExtensionProps, "x-go-type-import", target); err != nil {
+		return nil, fmt.Errorf("Expecting type: %s extension: x-go-type-import to be a path or an object with path and name", name)
+	}
+
+	descriptor := &TypeDescriptor{
+		Name:        r.kind(schema),
+		Default:     schema.Default,
+		Example:     schema.Example,
+		IsNullable:  schema.Nullable,
+		IsPrimitive: true,
+		Metadata: Metadata{
+			"go_type": kind,
+		},
+	}
+
+	// the other languages do not know the type
+	if descriptor.Name == "object" || descriptor.Name == "array" {
+		descriptor.IsAny = true
+	}
+
+	if target.Path != "" {
+		descriptor.Metadata["go_import"] = target.Path
+	}
+
+	if target.Name != "" {
+		descriptor.Metadata["go_import_name"] = target.Name
+	}
+
+	return descriptor, nil
+}
+
+// naming returns the metadata set by the x-go-name, x-stride-omit and
+// x-stride-pointer extensions. It returns nil if none of them is present.
+func (r *Resolver) naming(subject string, props openapi3.ExtensionProps) (Metadata, error) {
+	var (
+		name     string
+		omit     bool
+		pointer  bool
+		metadata = Metadata{}
+	)
+
+	if ok, err := r.unmarshal(props, "x-go-name", &name); err != nil {
+		return nil, fmt.Errorf("Expecting %s extension: x-go-name to be a string", subject)
+	} else if ok && name != "" {
+		metadata["go_name"] = name
+	}
+
+	if ok, err := r.unmarshal(props, "x-stride-omit", &omit); err != nil {
+		return nil, fmt.Errorf("Expecting %s extension: x-stride-omit to be a boolean", subject)
+	} else if ok {
+		metadata["go_omit"] = omit
+	}
+
+	if ok, err := r.unmarshal(props, "x-stride-pointer", &pointer); err != nil {
+		return nil, fmt.Errorf("Expecting %s extension: x-stride-pointer to be a boolean", subject)
+	} else if ok {
+		metadata["go_pointer"] = pointer
+	}
+
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+
+	return metadata, nil
+}
+
+func (r *Resolver) unmarshal(props openapi3.ExtensionProps, key string, value interface{}) (bool, error) {
+	extension, ok := props.Extensions[key]
+	if !ok {
+		return false, nil
+	}
+
+	// the extensions are raw json messages
+	data, err := json.Marshal(extension)
+	if err != nil {
+		return true, err
+	}
+
+	return true, json.Unmarshal(data, value)
+}
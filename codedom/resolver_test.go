@@ -4,9 +4,12 @@ import (
 	"fmt"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
 )
 
 var _ = Describe("Resolver", func() {
@@ -247,6 +250,19 @@ var _ = Describe("Resolver", func() {
 				ItResolvesEnumType("transaction-status", SchemaAt(1), values)
 			})
 
+			Describe("Enum number", func() {
+				BeforeEach(func() {
+					spec = resolve("schemas-enum-number.yaml")
+					Expect(spec.Types).To(HaveLen(2))
+				})
+
+				ItResolvesEnumType("priority", SchemaAt(0), []interface{}{-1.0, 0.0, 1.0})
+				ItResolvesPrimitiveType("int32", SchemaElementAt(0))
+
+				ItResolvesEnumType("ratio", SchemaAt(1), []interface{}{0.5, 1.5})
+				ItResolvesPrimitiveType("float64", SchemaElementAt(1))
+			})
+
 			Describe("Array", func() {
 				BeforeEach(func() {
 					spec = resolve("schemas-array.yaml")
@@ -316,6 +332,7 @@ var _ = Describe("Resolver", func() {
 					Expect(property.Name).To(Equal("id"))
 					Expect(property.PropertyType.Name).To(Equal("uuid"))
 					Expect(property.PropertyType.IsPrimitive).To(BeTrue())
+					Expect(property.PropertyType.Example).To(Equal("5995d6a2-01b3-423c-a173-5481df49bdaf"))
 
 					property = spec.Types[0].Properties[1]
 					Expect(property.Name).To(Equal("address"))
@@ -1135,6 +1152,423 @@ var _ = Describe("Resolver", func() {
 					Expect(property.PropertyType.Metadata).To(HaveKey("values"))
 				})
 			})
+
+			Describe("AllOf", func() {
+				BeforeEach(func() {
+					spec = resolve("schemas-all-of.yaml")
+					Expect(spec.Types).To(HaveLen(3))
+				})
+
+				ItResolvesObjectType("account", SchemaAt(0))
+				ItResolvesObjectType("base-entity", SchemaAt(1))
+				ItResolvesObjectType("person", SchemaAt(2))
+
+				It("embeds the referenced types", func() {
+					var property *codedom.PropertyDescriptor
+
+					descriptor := spec.Types[0]
+					Expect(descriptor.Properties).To(HaveLen(3))
+
+					property = descriptor.Properties[0]
+					Expect(property.Name).To(Equal("base-entity"))
+					Expect(property.IsEmbedded).To(BeTrue())
+					Expect(property.PropertyType.IsClass).To(BeTrue())
+					Expect(property.PropertyType.Properties).To(HaveLen(2))
+
+					property = descriptor.Properties[1]
+					Expect(property.Name).To(Equal("email"))
+					Expect(property.Required).To(BeFalse())
+
+					property = descriptor.Properties[2]
+					Expect(property.Name).To(Equal("name"))
+					Expect(property.Required).To(BeTrue())
+				})
+
+				It("merges the inline types", func() {
+					var property *codedom.PropertyDescriptor
+
+					descriptor := spec.Types[2]
+					Expect(descriptor.Properties).To(HaveLen(2))
+
+					property = descriptor.Properties[0]
+					Expect(property.Name).To(Equal("first_name"))
+					Expect(property.Required).To(BeTrue())
+					Expect(property.IsEmbedded).To(BeFalse())
+
+					property = descriptor.Properties[1]
+					Expect(property.Name).To(Equal("last_name"))
+					Expect(property.Required).To(BeFalse())
+					Expect(property.IsEmbedded).To(BeFalse())
+				})
+
+				Context("when the properties have conflicting types", func() {
+					It("returns an error", func() {
+						reporter := &fake.Reporter{}
+						reporter.WithReturns(reporter)
+
+						swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromFile("../fixture/spec/schemas-all-of-conflict.yaml")
+						Expect(err).To(BeNil())
+
+						resolver := &codedom.Resolver{
+							Reporter: reporter,
+							Cache:    codedom.TypeDescriptorMap{},
+						}
+
+						spec, err := resolver.Resolve(swagger)
+						Expect(err).NotTo(BeNil())
+						Expect(spec).To(BeNil())
+
+						messages := []string{}
+
+						for index := 0; index < reporter.ErrorCallCount(); index++ {
+							msg, args := reporter.ErrorArgsForCall(index)
+							messages = append(messages, fmt.Sprintf(msg, args...))
+						}
+
+						Expect(messages).To(ContainElement("Expecting type: account field: id to have the same type as embedded: base-entity field: id"))
+						Expect(messages).To(ContainElement("Expecting type: person field: name to have a single type declaration"))
+					})
+				})
+
+				Context("when the referenced types are not objects", func() {
+					BeforeEach(func() {
+						spec = resolve("schemas-all-of-alias.yaml")
+						Expect(spec.Types).To(HaveLen(5))
+					})
+
+					It("resolves the single reference to an alias", func() {
+						descriptor := spec.Types[1]
+						Expect(descriptor.Name).To(Equal("account-name"))
+						Expect(descriptor.Description).To(Equal("The name of the account"))
+						Expect(descriptor.IsAlias).To(BeTrue())
+						Expect(descriptor.Element.Name).To(Equal("name"))
+					})
+
+					It("resolves the property to the referenced type", func() {
+						property := spec.Types[0].Properties[1]
+						Expect(property.Name).To(Equal("status"))
+						Expect(property.Description).To(Equal("The status of the account"))
+						Expect(property.PropertyType.Name).To(Equal("account-status"))
+						Expect(property.PropertyType.IsEnum).To(BeTrue())
+					})
+
+					It("reverts the mixed clauses to generic types", func() {
+						property := spec.Types[0].Properties[0]
+						Expect(property.Name).To(Equal("code"))
+						Expect(property.PropertyType.IsAny).To(BeTrue())
+
+						descriptor := spec.Types[3]
+						Expect(descriptor.Name).To(Equal("mixed"))
+						Expect(descriptor.IsAlias).To(BeTrue())
+						Expect(descriptor.Element.IsAny).To(BeTrue())
+					})
+				})
+			})
+
+			Describe("OneOf", func() {
+				BeforeEach(func() {
+					spec = resolve("schemas-one-of.yaml")
+					Expect(spec.Types).To(HaveLen(5))
+				})
+
+				ItResolvesObjectType("bank-payment", SchemaAt(0))
+				ItResolvesObjectType("card-payment", SchemaAt(1))
+				ItResolvesAliasType("untyped", SchemaAt(4))
+
+				It("resolves the union with discriminator mapping", func() {
+					descriptor := spec.Types[3]
+					Expect(descriptor.Name).To(Equal("payment"))
+					Expect(descriptor.IsUnion).To(BeTrue())
+					Expect(descriptor.IsClass).To(BeFalse())
+					Expect(descriptor.Metadata).To(HaveKeyWithValue("discriminator", "payment_type"))
+					Expect(descriptor.Properties).To(HaveLen(2))
+
+					Expect(descriptor.Properties[0].Name).To(Equal("BankPayment"))
+					Expect(descriptor.Properties[0].PropertyType.Name).To(Equal("bank-payment"))
+					Expect(descriptor.Properties[0].PropertyType.IsClass).To(BeTrue())
+
+					Expect(descriptor.Properties[1].Name).To(Equal("card"))
+					Expect(descriptor.Properties[1].PropertyType.Name).To(Equal("card-payment"))
+					Expect(descriptor.Properties[1].PropertyType.IsClass).To(BeTrue())
+				})
+
+				It("resolves the any-of union with discriminator", func() {
+					descriptor := spec.Types[2]
+					Expect(descriptor.Name).To(Equal("event"))
+					Expect(descriptor.IsUnion).To(BeTrue())
+					Expect(descriptor.Properties).To(HaveLen(2))
+					Expect(descriptor.Properties[0].Name).To(Equal("BankPayment"))
+					Expect(descriptor.Properties[1].Name).To(Equal("CardPayment"))
+				})
+
+				It("resolves the union without discriminator as generic type", func() {
+					descriptor := spec.Types[4]
+					Expect(descriptor.Element.IsAny).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("Requests", func() {
+		Context("when the content types have different schemas", func() {
+			BeforeEach(func() {
+				spec = resolve("requests-content-types.yaml")
+			})
+
+			It("resolves a type per content type", func() {
+				Expect(spec.Types).To(HaveLen(2))
+				Expect(spec.Types[0].Name).To(Equal("account"))
+				Expect(spec.Types[1].Name).To(Equal("create-account-request-form"))
+
+				operations := spec.Controllers[0].Operations
+				Expect(operations).To(HaveLen(2))
+
+				requests := operations[0].Requests
+				Expect(requests).To(HaveLen(3))
+				Expect(requests[0].ContentType).To(Equal("application/json"))
+				Expect(requests[0].RequestType.Name).To(Equal("account"))
+				Expect(requests[1].ContentType).To(Equal("application/x-www-form-urlencoded"))
+				Expect(requests[1].RequestType.Name).To(Equal("create-account-request-form"))
+				Expect(requests[2].ContentType).To(Equal("application/xml"))
+				Expect(requests[2].RequestType.Name).To(Equal("account"))
+
+				requests = operations[1].Requests
+				Expect(requests).To(HaveLen(2))
+				Expect(requests[0].RequestType).To(Equal(requests[1].RequestType))
+			})
+		})
+
+		Context("when the content type is multipart", func() {
+			BeforeEach(func() {
+				spec = resolve("requests-multipart.yaml")
+			})
+
+			It("resolves the encoding of the parts", func() {
+				operation := spec.Controllers[0].Operations[1]
+				Expect(operation.Name).To(Equal("upload-document"))
+
+				request := operation.Requests[0]
+				Expect(request.ContentType).To(Equal("multipart/form-data"))
+				Expect(request.Encoding).To(HaveKeyWithValue("file", "application/pdf"))
+				Expect(request.Encoding).To(HaveKeyWithValue("metadata", "application/json"))
+
+				property := request.RequestType.Properties[1]
+				Expect(property.Name).To(Equal("file"))
+				Expect(property.PropertyType.IsBinary()).To(BeTrue())
+				Expect(property.PropertyType.Metadata).NotTo(HaveKey("min"))
+			})
+
+			It("resolves the binary response", func() {
+				operation := spec.Controllers[0].Operations[0]
+				Expect(operation.Name).To(Equal("download-document"))
+
+				response := operation.Responses[0]
+				Expect(response.ContentType).To(Equal("application/octet-stream"))
+				Expect(response.ResponseType.IsBinary()).To(BeTrue())
+				Expect(response.ResponseType.Kind()).To(Equal("*File"))
+			})
+		})
+	})
+
+	Describe("Responses", func() {
+		BeforeEach(func() {
+			spec = resolve("requests-codecs.yaml")
+		})
+
+		It("resolves a response per content type", func() {
+			operations := spec.Controllers[0].Operations
+			Expect(operations).To(HaveLen(2))
+
+			responses := operations[0].Responses
+			Expect(responses).To(HaveLen(2))
+			Expect(responses[0].Code).To(Equal(201))
+			Expect(responses[0].ContentType).To(Equal("application/vnd.api+json"))
+			Expect(responses[1].Code).To(Equal(201))
+			Expect(responses[1].ContentType).To(Equal("application/xml"))
+			Expect(responses[0].ResponseType).To(Equal(responses[1].ResponseType))
+
+			responses = operations[1].Responses
+			Expect(responses).To(HaveLen(2))
+			Expect(responses[0].Code).To(Equal(404))
+			Expect(responses[0].ContentType).To(Equal("text/csv"))
+			Expect(responses[1].Code).To(Equal(200))
+			Expect(responses[1].ContentType).To(Equal("text/plain"))
+		})
+	})
+
+	Describe("Security", func() {
+		BeforeEach(func() {
+			spec = resolve("security.yaml")
+		})
+
+		It("resolves the security schemes", func() {
+			schemes := spec.SecuritySchemes
+			Expect(schemes).To(HaveLen(5))
+
+			Expect(schemes[0].Name).To(Equal("ApiKey"))
+			Expect(schemes[0].Type).To(Equal("apiKey"))
+			Expect(schemes[0].In).To(Equal("header"))
+			Expect(schemes[0].Key).To(Equal("X-API-Key"))
+			Expect(schemes[0].Description).To(Equal("The API key of the client"))
+
+			Expect(schemes[2].Name).To(Equal("BearerAuth"))
+			Expect(schemes[2].Type).To(Equal("http"))
+			Expect(schemes[2].Scheme).To(Equal("bearer"))
+			Expect(schemes[2].BearerFormat).To(Equal("JWT"))
+		})
+
+		It("resolves the security requirements of the operations", func() {
+			operations := spec.Controllers[0].Operations
+			Expect(operations).To(HaveLen(4))
+
+			operation := operations[0]
+			Expect(operation.Name).To(Equal("create-pet"))
+			Expect(operation.Security).To(HaveLen(1))
+			Expect(operation.Security[0]).To(HaveLen(1))
+			Expect(operation.Security[0][0].Name).To(Equal("PetStoreAuth"))
+			Expect(operation.Security[0][0].Scopes).To(ConsistOf("write:pets", "read:pets"))
+
+			operation = operations[1]
+			Expect(operation.Name).To(Equal("delete-pet"))
+			Expect(operation.Security).To(HaveLen(1))
+			Expect(operation.Security[0]).To(HaveLen(2))
+			Expect(operation.Security[0][0].Name).To(Equal("BasicAuth"))
+			Expect(operation.Security[0][1].Name).To(Equal("SessionKey"))
+
+			operation = operations[2]
+			Expect(operation.Name).To(Equal("get-pet"))
+			Expect(operation.IsSecured()).To(BeTrue())
+			Expect(operation.Security).To(HaveLen(2))
+			Expect(operation.Security[0][0].Name).To(Equal("ApiKey"))
+			Expect(operation.Security[1][0].Name).To(Equal("BearerAuth"))
+
+			operation = operations[3]
+			Expect(operation.Name).To(Equal("list-pets"))
+			Expect(operation.IsSecured()).To(BeFalse())
+
+			Expect(spec.SecuritySchemes[4].Scopes).To(BeEmpty())
+		})
+	})
+
+	Describe("Callbacks", func() {
+		BeforeEach(func() {
+			spec = resolve("callbacks.yaml")
+		})
+
+		It("resolves the callbacks of the operations", func() {
+			operation := spec.Controllers[0].Operations[0]
+			Expect(operation.Name).To(Equal("create-subscription"))
+			Expect(operation.Callbacks).To(HaveLen(2))
+
+			callback := operation.Callbacks[0]
+			Expect(callback.Name).To(Equal("onClose"))
+			Expect(callback.Operations).To(HaveLen(1))
+			Expect(callback.Operations[0].Name).To(Equal("close-subscription"))
+			Expect(callback.Operations[0].Path).To(Equal("{$request.body#/callbackUrl}/close"))
+			Expect(callback.Operations[0].Requests[0].Parameters).To(HaveLen(1))
+
+			callback = operation.Callbacks[1]
+			Expect(callback.Name).To(Equal("onEvent"))
+			Expect(callback.Operations).To(HaveLen(1))
+			Expect(callback.Operations[0].Name).To(Equal("create-subscription-on-event"))
+			Expect(callback.Operations[0].Method).To(Equal("POST"))
+			Expect(callback.Operations[0].Path).To(Equal("{$request.body#/callbackUrl}"))
+			Expect(callback.Operations[0].Requests[0].RequestType.Name).To(Equal("event"))
+			Expect(callback.Operations[0].Responses[0].Code).To(Equal(204))
+		})
+	})
+
+	Describe("Links", func() {
+		BeforeEach(func() {
+			spec = resolve("links.yaml")
+		})
+
+		It("resolves the links of the responses", func() {
+			Expect(spec.Controllers).To(HaveLen(2))
+
+			operations := spec.Controllers[1].Operations
+			Expect(operations).To(HaveLen(3))
+			Expect(operations[0].Name).To(Equal("create-user"))
+
+			links := operations[0].Responses[0].Links
+			Expect(links).To(HaveLen(2))
+
+			Expect(links[0].Name).To(Equal("GetUserByUserId"))
+			Expect(links[0].Controller).To(Equal("user"))
+			Expect(links[0].Operation).To(Equal(operations[2]))
+			Expect(links[0].Parameters).To(HaveKeyWithValue("userId", "$response.body#/id"))
+
+			Expect(links[1].Name).To(Equal("ListUserOrders"))
+			Expect(links[1].Controller).To(Equal("order"))
+			Expect(links[1].Operation.Name).To(Equal("list-orders"))
+			Expect(links[1].Parameters).To(HaveKeyWithValue("limit", "10"))
+		})
+
+		It("resolves the links by operation reference", func() {
+			operation := spec.Controllers[1].Operations[2]
+			Expect(operation.Name).To(Equal("get-user"))
+
+			links := operation.Responses[0].Links
+			Expect(links).To(HaveLen(1))
+			Expect(links[0].Name).To(Equal("DeleteUser"))
+			Expect(links[0].OperationRef).To(Equal("#/paths/~1users~1{userId}/delete"))
+			Expect(links[0].Operation.Name).To(Equal("delete-user"))
+			Expect(links[0].Parameters).To(HaveKeyWithValue("path.userId", "$request.path.userId"))
+		})
+	})
+
+	Describe("Operation Parameters", func() {
+		BeforeEach(func() {
+			spec = resolve("parameters-merge.yaml")
+			Expect(spec.Types).To(HaveLen(2))
+		})
+
+		ItResolvesAliasType("account-id", SchemaAt(0))
+		ItResolvesEnumType("account-status", SchemaAt(1), []interface{}{"pending", "completed"})
+
+		It("merges the path item parameters", func() {
+			operation := spec.Controllers[0].Operations[0]
+			Expect(operation.Name).To(Equal("delete-account"))
+
+			parameters := operation.Requests[0].Parameters
+			Expect(parameters).To(HaveLen(2))
+
+			Expect(parameters[0].Name).To(Equal("id"))
+			Expect(parameters[0].In).To(Equal("path"))
+			Expect(parameters[0].ParameterType).To(Equal(spec.Types[0]))
+
+			Expect(parameters[1].Name).To(Equal("status"))
+			Expect(parameters[1].In).To(Equal("query"))
+			Expect(parameters[1].ParameterType.Name).To(Equal("string"))
+		})
+
+		It("overrides the path item parameters by name and location", func() {
+			operation := spec.Controllers[0].Operations[1]
+			Expect(operation.Name).To(Equal("get-account"))
+
+			parameters := operation.Requests[0].Parameters
+			Expect(parameters).To(HaveLen(3))
+
+			Expect(parameters[0].Name).To(Equal("id"))
+			Expect(parameters[0].In).To(Equal("path"))
+			Expect(parameters[0].ParameterType).To(Equal(spec.Types[0]))
+
+			Expect(parameters[1].Name).To(Equal("id"))
+			Expect(parameters[1].In).To(Equal("query"))
+			Expect(parameters[1].ParameterType.Name).To(Equal("string"))
+
+			Expect(parameters[2].Name).To(Equal("status"))
+			Expect(parameters[2].In).To(Equal("query"))
+			Expect(parameters[2].ParameterType).To(Equal(spec.Types[1]))
+		})
+
+		It("shares the types of the referenced parameters", func() {
+			operation := spec.Controllers[1].Operations[0]
+			Expect(operation.Name).To(Equal("list-transactions"))
+
+			parameters := operation.Requests[0].Parameters
+			Expect(parameters).To(HaveLen(1))
+			Expect(parameters[0].ParameterType).To(Equal(spec.Types[1]))
 		})
 	})
 
@@ -1170,4 +1604,315 @@ var _ = Describe("Resolver", func() {
 			Expect(op.Responses).To(HaveLen(1))
 		})
 	})
+
+	Describe("Mapping", func() {
+		var resolver *codedom.Resolver
+
+		load := func(name string) *openapi3.Swagger {
+			swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromFile("../fixture/spec/" + name)
+			Expect(err).To(BeNil())
+			return swagger
+		}
+
+		BeforeEach(func() {
+			reporter := &fake.Reporter{}
+			reporter.WithReturns(reporter)
+
+			resolver = &codedom.Resolver{
+				Reporter: reporter,
+				Cache:    codedom.TypeDescriptorMap{},
+			}
+		})
+
+		It("maps the primitive types", func() {
+			resolver.Types = codedom.TypeMapping{
+				"string/uuid": "github.com/google/uuid.UUID",
+				"string":      "github.com/phogolabs/schema.Text",
+			}
+
+			spec, err := resolver.Resolve(load("schemas-string.yaml"))
+			Expect(err).To(BeNil())
+
+			types := map[string]*codedom.TypeDescriptor{}
+
+			for _, descriptor := range spec.Types {
+				types[descriptor.Name] = descriptor.Element
+			}
+
+			Expect(types["uuid-kind"].Kind()).To(Equal("uuid.UUID"))
+			Expect(types["uuid-kind"].Namespace()).To(Equal("github.com/google/uuid"))
+			Expect(types["uuid-kind"].Metadata).To(Equal(codedom.Metadata{"go_type": "github.com/google/uuid.UUID"}))
+			Expect(types["date-kind"].Kind()).To(Equal("schema.Text"))
+			Expect(types["date-kind"].Namespace()).To(Equal("github.com/phogolabs/schema"))
+		})
+
+		Describe("x-go-type", func() {
+			It("resolves the extension", func() {
+				spec, err := resolver.Resolve(load("schemas-extension.yaml"))
+				Expect(err).To(BeNil())
+				Expect(spec.Types).To(HaveLen(2))
+
+				descriptor := spec.Types[0]
+				Expect(descriptor.Name).To(Equal("amount"))
+				Expect(descriptor.IsAlias).To(BeTrue())
+				Expect(descriptor.Element.Kind()).To(Equal("decimal.Decimal"))
+				Expect(descriptor.Element.Namespace()).To(Equal("github.com/shopspring/decimal"))
+
+				properties := map[string]*codedom.TypeDescriptor{}
+
+				for _, property := range spec.Types[1].Properties {
+					properties[property.Name] = property.PropertyType
+				}
+
+				Expect(properties["amount"].Kind()).To(Equal("decimal.Decimal"))
+				Expect(properties["id"].Kind()).To(Equal("uuid.UUID"))
+				Expect(properties["id"].Namespace()).To(Equal("github.com/google/uuid"))
+				Expect(properties["ip"].Kind()).To(Equal("net.IP"))
+				Expect(properties["ip"].Namespace()).To(Equal("net"))
+				Expect(properties["fee"].Kind()).To(Equal("dec.Decimal"))
+				Expect(properties["fee"].ImportName()).To(Equal("dec"))
+				Expect(properties["metadata"].Kind()).To(Equal("json.RawMessage"))
+				Expect(properties["metadata"].Namespace()).To(Equal("encoding/json"))
+				Expect(properties["metadata"].IsAny).To(BeTrue())
+			})
+
+			It("has precedence over the type mapping", func() {
+				resolver.Types = codedom.TypeMapping{
+					"string/uuid": "github.com/gofrs/uuid.UUID",
+				}
+
+				spec, err := resolver.Resolve(load("schemas-extension.yaml"))
+				Expect(err).To(BeNil())
+
+				for _, property := range spec.Types[1].Properties {
+					if property.Name == "id" {
+						Expect(property.PropertyType.Namespace()).To(Equal("github.com/google/uuid"))
+					}
+				}
+			})
+
+			Context("when the extension is not a string", func() {
+				It("returns an error", func() {
+					swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(`
+openapi: 3.0.1
+components:
+  schemas:
+    Amount:
+      type: string
+      x-go-type: 42
+`))
+					Expect(err).To(BeNil())
+
+					spec, err := resolver.Resolve(swagger)
+					Expect(err).To(HaveOccurred())
+					Expect(spec).To(BeNil())
+				})
+			})
+		})
+
+		Describe("x-go-name", func() {
+			It("resolves the extensions", func() {
+				spec, err := resolver.Resolve(load("schemas-naming.yaml"))
+				Expect(err).To(BeNil())
+				Expect(spec.Types).To(HaveLen(1))
+
+				descriptor := spec.Types[0]
+				Expect(descriptor.Name).To(Equal("api-key"))
+				Expect(descriptor.Identifier()).To(Equal("APIKey"))
+
+				properties := map[string]*codedom.PropertyDescriptor{}
+
+				for _, property := range descriptor.Properties {
+					properties[property.Name] = property
+				}
+
+				Expect(properties["id"].Identifier()).To(Equal("KeyID"))
+				Expect(properties["secret"].IsOmitted()).To(BeTrue())
+				Expect(properties["name"].Kind()).To(Equal("*string"))
+				Expect(properties["owner"].Kind()).To(Equal("string"))
+
+				Expect(spec.Controllers).To(HaveLen(1))
+
+				operation := spec.Controllers[0].Operations[0]
+				Expect(operation.Name).To(Equal("fetch-api-key"))
+				Expect(operation.Identifier()).To(Equal("FetchAPIKey"))
+				Expect(operation.Responses[0].ResponseType.Kind()).To(Equal("*APIKey"))
+
+				parameter := operation.Requests[0].Parameters[0]
+				Expect(parameter.Name).To(Equal("keyId"))
+				Expect(parameter.Identifier()).To(Equal("KeyID"))
+			})
+
+			Context("when the operation is renamed by the config", func() {
+				It("ignores the extension", func() {
+					resolver.Names = &codedom.NameMapping{
+						Operations: map[string]string{"getKeyById": "getKey"},
+					}
+
+					spec, err := resolver.Resolve(load("schemas-naming.yaml"))
+					Expect(err).To(BeNil())
+
+					operation := spec.Controllers[0].Operations[0]
+					Expect(operation.Name).To(Equal("get-key"))
+					Expect(operation.Identifier()).To(Equal("get-key"))
+				})
+			})
+
+			Context("when the extension is not a string", func() {
+				It("returns an error", func() {
+					swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(`
+openapi: 3.0.1
+components:
+  schemas:
+    Account:
+      type: object
+      properties:
+        id:
+          type: string
+          x-go-name: true
+`))
+					Expect(err).To(BeNil())
+
+					spec, err := resolver.Resolve(swagger)
+					Expect(err).To(HaveOccurred())
+					Expect(spec).To(BeNil())
+				})
+			})
+		})
+
+		It("overrides the names", func() {
+			resolver.Names = &codedom.NameMapping{
+				Types:       map[string]string{"Account": "customer"},
+				Controllers: map[string]string{"account": "bank"},
+				Operations:  map[string]string{"getAccounts": "listCustomers"},
+			}
+
+			spec, err := resolver.Resolve(load("operations.yaml"))
+			Expect(err).To(BeNil())
+
+			Expect(spec.Types).To(HaveLen(2))
+			Expect(spec.Types[0].Name).To(Equal("account-array"))
+			Expect(spec.Types[1].Name).To(Equal("customer"))
+			Expect(spec.Types[0].Element.Kind()).To(Equal("*Customer"))
+
+			Expect(spec.Controllers).To(HaveLen(1))
+			Expect(spec.Controllers[0].Name).To(Equal("bank"))
+			Expect(spec.Controllers[0].Operations[0].Name).To(Equal("get-account-by-id"))
+			Expect(spec.Controllers[0].Operations[1].Name).To(Equal("list-customers"))
+		})
+
+		Context("when more than one name matches", func() {
+			It("prefers the exact name", func() {
+				for i := 0; i < 10; i++ {
+					resolver.Names = &codedom.NameMapping{
+						Controllers: map[string]string{"account": "bank", "Account": "customer"},
+					}
+
+					spec, err := resolver.Resolve(load("operations.yaml"))
+					Expect(err).To(BeNil())
+					Expect(spec.Controllers[0].Name).To(Equal("bank"))
+				}
+			})
+		})
+
+		Context("when the type is renamed to a declared type", func() {
+			It("returns an error", func() {
+				resolver.Names = &codedom.NameMapping{
+					Types: map[string]string{"account": "account-array"},
+				}
+
+				spec, err := resolver.Resolve(load("operations.yaml"))
+				Expect(err).To(HaveOccurred())
+				Expect(spec).To(BeNil())
+			})
+		})
+	})
+
+	Describe("Constraints", func() {
+		float64Ptr := func(v float64) *float64 {
+			return &v
+		}
+
+		DescribeTable("number",
+			func(name string, index int, metadata codedom.Metadata, tags []string) {
+				spec = resolve(name)
+				Expect(spec.Types).To(HaveLen(4))
+
+				descriptor := spec.Types[index].Element
+				Expect(descriptor.IsPrimitive).To(BeTrue())
+
+				for key, value := range metadata {
+					Expect(descriptor.Metadata).To(HaveKeyWithValue(key, value))
+				}
+
+				tag := descriptor.Tags(true)[0]
+				Expect(tag.Name).To(Equal("required"))
+				Expect(tag.Options).To(ConsistOf(tags))
+			},
+			Entry("schemas double", "schemas-number.yaml", 0, codedom.Metadata{
+				"min":           float64Ptr(0),
+				"max":           float64Ptr(100),
+				"min_exclusive": false,
+				"max_exclusive": true,
+				"multiple_of":   float64Ptr(0.5),
+			}, []string{"gte=0", "lt=100", "multipleof=0.5"}),
+			Entry("schemas float", "schemas-number.yaml", 2, codedom.Metadata{
+				"min":           float64Ptr(-1.5),
+				"max":           float64Ptr(1.5),
+				"min_exclusive": true,
+				"max_exclusive": false,
+			}, []string{"gt=-1.5", "lte=1.5"}),
+			Entry("parameters double", "parameters-number.yaml", 0, codedom.Metadata{
+				"max_exclusive": true,
+				"multiple_of":   float64Ptr(0.5),
+			}, []string{"gte=0", "lt=100", "multipleof=0.5"}),
+			Entry("parameters float", "parameters-number.yaml", 2, codedom.Metadata{
+				"min_exclusive": true,
+			}, []string{"gt=-1.5", "lte=1.5"}),
+			Entry("headers double", "headers-number.yaml", 0, codedom.Metadata{
+				"max_exclusive": true,
+				"multiple_of":   float64Ptr(0.5),
+			}, []string{"gte=0", "lt=100", "multipleof=0.5"}),
+			Entry("headers float", "headers-number.yaml", 2, codedom.Metadata{
+				"min_exclusive": true,
+			}, []string{"gt=-1.5", "lte=1.5"}),
+			Entry("requests double", "requests-number.yaml", 0, codedom.Metadata{
+				"max_exclusive": true,
+				"multiple_of":   float64Ptr(0.5),
+			}, []string{"gte=0", "lt=100", "multipleof=0.5"}),
+			Entry("requests float", "requests-number.yaml", 2, codedom.Metadata{
+				"min_exclusive": true,
+			}, []string{"gt=-1.5", "lte=1.5"}),
+			Entry("responses double", "responses-number.yaml", 0, codedom.Metadata{
+				"max_exclusive": true,
+				"multiple_of":   float64Ptr(0.5),
+			}, []string{"gte=0", "lt=100", "multipleof=0.5"}),
+			Entry("responses float", "responses-number.yaml", 2, codedom.Metadata{
+				"min_exclusive": true,
+			}, []string{"gt=-1.5", "lte=1.5"}),
+		)
+
+		DescribeTable("array",
+			func(name string) {
+				spec = resolve(name)
+				Expect(spec.Types).To(HaveLen(2))
+
+				descriptor := spec.Types[0]
+				Expect(descriptor.IsArray).To(BeTrue())
+				Expect(descriptor.Metadata).To(HaveKeyWithValue("min", float64Ptr(1)))
+				Expect(descriptor.Metadata).To(HaveKeyWithValue("max", float64Ptr(10)))
+				Expect(descriptor.Metadata).To(HaveKeyWithValue("min_exclusive", false))
+				Expect(descriptor.Metadata).To(HaveKeyWithValue("max_exclusive", false))
+				Expect(descriptor.Metadata).To(HaveKeyWithValue("unique", true))
+
+				tag := descriptor.Tags(false)[0]
+				Expect(append([]string{tag.Name}, tag.Options...)).To(ConsistOf("gte=1", "lte=10", "unique"))
+			},
+			Entry("schemas", "schemas-array.yaml"),
+			Entry("parameters", "parameters-array.yaml"),
+			Entry("headers", "headers-array.yaml"),
+			Entry("requests", "requests-array.yaml"),
+			Entry("responses", "responses-array.yaml"),
+		)
+	})
 })
@@ -0,0 +1,181 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+)
+
+// Generator generates the source code with an external executable
+type Generator struct {
+	Name     string
+	Command  string
+	Path     string
+	Options  map[string]string
+	Reporter contract.Reporter
+}
+
+// Generate generates the source code
+func (g *Generator) Generate(spec *codedom.SpecDescriptor) error {
+	reporter := g.Reporter.With(contract.SeverityVeryHigh)
+	reporter.Notice(" Generating with plugin: %s...", g.Name)
+
+	response, err := g.run(spec)
+	if err != nil {
+		reporter.Error(" Generating with plugin: %s fail: %v", g.Name, err)
+		return err
+	}
+
+	for _, file := range response.Files {
+		if err := g.sync(file); err != nil {
+			reporter.Error(" Generating with plugin: %s fail", g.Name)
+			return err
+		}
+	}
+
+	reporter.Success(" Generating with plugin: %s complete!", g.Name)
+	return nil
+}
+
+func (g *Generator) run(spec *codedom.SpecDescriptor) (*Response, error) {
+	request := &Request{
+		Version:   Version,
+		Generator: g.Name,
+		Path:      g.Path,
+		Options:   g.Options,
+		Spec:      Encode(spec),
+	}
+
+	if request.Options == nil {
+		request.Options = map[string]string{}
+	}
+
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		output = &bytes.Buffer{}
+		errput = &bytes.Buffer{}
+	)
+
+	cmd := exec.Command(g.Command)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = output
+	cmd.Stderr = errput
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(errput.String()); message != "" {
+			return nil, fmt.Errorf("plugin %v: %v: %v", g.Name, err, message)
+		}
+
+		return nil, fmt.Errorf("plugin %v: %v", g.Name, err)
+	}
+
+	response := &Response{}
+
+	if err := json.NewDecoder(output).Decode(response); err != nil {
+		return nil, fmt.Errorf("plugin %v: invalid response: %v", g.Name, err)
+	}
+
+	if response.Version != Version {
+		return nil, fmt.Errorf("plugin %v: unsupported protocol version: %d", g.Name, response.Version)
+	}
+
+	if response.Error != "" {
+		return nil, fmt.Errorf("plugin %v: %v", g.Name, response.Error)
+	}
+
+	return response, nil
+}
+
+func (g *Generator) sync(file *File) error {
+	reporter := g.Reporter.With(contract.SeverityLow)
+
+	name := filepath.Clean(filepath.FromSlash(file.Name))
+
+	// the plugins cannot write outside of the project directory
+	if filepath.IsAbs(name) || name == "." || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+		err := fmt.Errorf("plugin %v: invalid file name: %v", g.Name, file.Name)
+		reporter.Error(" Sync file: %s fail: %v", file.Name, err)
+		return err
+	}
+
+	name = filepath.Join(g.Path, name)
+
+	if _, err := os.Stat(name); err == nil && file.Scaffold {
+		reporter.Info(" Skipping file: %s. It already exists", name)
+		return nil
+	}
+
+	reporter.Info(" Sync file: %s...", name)
+
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		reporter.Error(" Sync file: %s fail: %v", name, err)
+		return err
+	}
+
+	if err := ioutil.WriteFile(name, []byte(file.Content), 0644); err != nil {
+		reporter.Error(" Sync file: %s fail: %v", name, err)
+		return err
+	}
+
+	reporter.Success(" Sync file: %s successful", name)
+	return nil
+}
+
+// Executable is a plugin executable
+type Executable struct {
+	Name    string
+	Command string
+}
+
+// Discover returns the plugins in the given directories. The first
+// executable with a given name wins like in the PATH lookup.
+func Discover(dirs []string) []*Executable {
+	var (
+		executables = []*Executable{}
+		names       = map[string]bool{}
+	)
+
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || entry.Mode()&0111 == 0 || !strings.HasPrefix(entry.Name(), Prefix) {
+				continue
+			}
+
+			name := strings.TrimSuffix(strings.TrimPrefix(entry.Name(), Prefix), ".exe")
+
+			if name == "" || names[name] {
+				continue
+			}
+
+			names[name] = true
+
+			executables = append(executables, &Executable{
+				Name:    name,
+				Command: filepath.Join(dir, entry.Name()),
+			})
+		}
+	}
+
+	return executables
+}
@@ -0,0 +1,165 @@
+package plugin_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/plugin"
+)
+
+var _ = Describe("Generator", func() {
+	var (
+		generator *plugin.Generator
+		spec      *codedom.SpecDescriptor
+		bin       string
+	)
+
+	BeforeEach(func() {
+		reporter := &fake.Reporter{}
+		reporter.WithReturns(reporter)
+
+		spec = resolve("../fixture/spec/web-api.yaml")
+		bin = tmpdir()
+
+		generator = &plugin.Generator{
+			Name:     "example",
+			Command:  example,
+			Path:     tmpdir(),
+			Reporter: reporter,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(generator.Path)).To(Succeed())
+		Expect(os.RemoveAll(bin)).To(Succeed())
+	})
+
+	It("writes the files of the plugin", func() {
+		Expect(generator.Generate(spec)).To(Succeed())
+
+		data, err := ioutil.ReadFile(filepath.Join(generator.Path, "routes.txt"))
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring("GET /accounts list-accounts\n"))
+		Expect(string(data)).To(ContainSubstring("type payment union\n"))
+	})
+
+	Context("when the options are provided", func() {
+		BeforeEach(func() {
+			generator.Options = map[string]string{"file": "docs/api.txt"}
+		})
+
+		It("passes them to the plugin", func() {
+			Expect(generator.Generate(spec)).To(Succeed())
+			Expect(filepath.Join(generator.Path, "docs", "api.txt")).To(BeARegularFile())
+		})
+	})
+
+	Context("when the plugin reports an error", func() {
+		BeforeEach(func() {
+			generator.Options = map[string]string{"format": "html"}
+		})
+
+		It("returns the error", func() {
+			Expect(generator.Generate(spec)).To(MatchError("plugin example: unknown option: format"))
+		})
+	})
+
+	Context("when the plugin fails", func() {
+		BeforeEach(func() {
+			generator.Command = script(bin, "stride-gen-fail", "echo 'oh no' >&2\nexit 3\n")
+		})
+
+		It("returns an error", func() {
+			Expect(generator.Generate(spec)).To(MatchError("plugin example: exit status 3: oh no"))
+		})
+	})
+
+	Context("when the plugin uses another protocol version", func() {
+		BeforeEach(func() {
+			generator.Command = script(bin, "stride-gen-old", "cat > /dev/null\necho '{\"version\": 0, \"files\": []}'\n")
+		})
+
+		It("returns an error", func() {
+			Expect(generator.Generate(spec)).To(MatchError("plugin example: unsupported protocol version: 0"))
+		})
+	})
+
+	Context("when the plugin writes outside of the project", func() {
+		BeforeEach(func() {
+			generator.Command = script(bin, "stride-gen-evil", "cat > /dev/null\necho '{\"version\": 1, \"files\": [{\"name\": \"../evil.txt\", \"content\": \"\"}]}'\n")
+		})
+
+		It("returns an error", func() {
+			Expect(generator.Generate(spec)).To(MatchError("plugin example: invalid file name: ../evil.txt"))
+		})
+	})
+
+	Context("when the file name starts with dots", func() {
+		BeforeEach(func() {
+			generator.Command = script(bin, "stride-gen-dots", "cat > /dev/null\necho '{\"version\": 1, \"files\": [{\"name\": \"..config.yaml\", \"content\": \"generated\"}]}'\n")
+		})
+
+		It("writes the file in the project", func() {
+			Expect(generator.Generate(spec)).To(Succeed())
+
+			data, err := ioutil.ReadFile(filepath.Join(generator.Path, "..config.yaml"))
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal("generated"))
+		})
+	})
+
+	Context("when the file is a scaffold", func() {
+		BeforeEach(func() {
+			generator.Command = script(bin, "stride-gen-scaffold", "cat > /dev/null\necho '{\"version\": 1, \"files\": [{\"name\": \"main.go\", \"content\": \"generated\", \"scaffold\": true}]}'\n")
+		})
+
+		It("does not overwrite the existing file", func() {
+			path := filepath.Join(generator.Path, "main.go")
+			Expect(ioutil.WriteFile(path, []byte("user code"), 0644)).To(Succeed())
+
+			Expect(generator.Generate(spec)).To(Succeed())
+
+			data, err := ioutil.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal("user code"))
+		})
+	})
+})
+
+var _ = Describe("Discover", func() {
+	var dir []string
+
+	BeforeEach(func() {
+		dir = []string{tmpdir(), tmpdir()}
+
+		script(dir[0], "stride-gen-example", "")
+		script(dir[1], "stride-gen-example", "")
+		script(dir[1], "stride-gen-rust", "")
+		script(dir[1], "stride", "")
+
+		Expect(ioutil.WriteFile(filepath.Join(dir[1], "stride-gen-text"), []byte{}, 0644)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		for _, path := range dir {
+			Expect(os.RemoveAll(path)).To(Succeed())
+		}
+	})
+
+	It("returns the plugin executables", func() {
+		executables := plugin.Discover(append(dir, "", "/i-do-not-exist"))
+		Expect(executables).To(HaveLen(2))
+
+		Expect(executables[0].Name).To(Equal("example"))
+		Expect(executables[0].Command).To(Equal(filepath.Join(dir[0], "stride-gen-example")))
+
+		Expect(executables[1].Name).To(Equal("rust"))
+		Expect(executables[1].Command).To(Equal(filepath.Join(dir[1], "stride-gen-rust")))
+	})
+})
@@ -0,0 +1,80 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Version is the version of the plugin protocol
+const Version = 1
+
+// Prefix is the prefix of the plugin executables
+const Prefix = "stride-gen-"
+
+// Request is written to the standard input of the plugin
+type Request struct {
+	// Version of the protocol
+	Version int `json:"version"`
+	// Generator is the name of the plugin
+	Generator string `json:"generator"`
+	// Path to the project directory
+	Path string `json:"path"`
+	// Options of the plugin passed by --opt <generator>.<name>=<value>
+	Options map[string]string `json:"options"`
+	// Spec is the resolved specification
+	Spec *Spec `json:"spec"`
+}
+
+// Response is read from the standard output of the plugin
+type Response struct {
+	// Version of the protocol
+	Version int `json:"version"`
+	// Error is reported when the plugin cannot generate the files
+	Error string `json:"error,omitempty"`
+	// Files that should be written to the project directory
+	Files []*File `json:"files"`
+}
+
+// File is a file generated by the plugin
+type File struct {
+	// Name is the slash separated path relative to the project directory
+	Name string `json:"name"`
+	// Content of the file
+	Content string `json:"content"`
+	// Scaffold files are written only if they do not exist
+	Scaffold bool `json:"scaffold,omitempty"`
+}
+
+// Handler generates the files of a request
+type Handler func(request *Request) ([]*File, error)
+
+// Serve reads a request from the reader and writes the response of the
+// handler to the writer. It's used by the plugins written in Go.
+func Serve(reader io.Reader, writer io.Writer, handler Handler) error {
+	request := &Request{}
+
+	if err := json.NewDecoder(reader).Decode(request); err != nil {
+		return err
+	}
+
+	response := &Response{
+		Version: Version,
+		Files:   []*File{},
+	}
+
+	switch {
+	case request.Version != Version:
+		response.Error = fmt.Sprintf("unsupported protocol version: %d", request.Version)
+	default:
+		files, err := handler(request)
+
+		if err != nil {
+			response.Error = err.Error()
+		} else if files != nil {
+			response.Files = files
+		}
+	}
+
+	return json.NewEncoder(writer).Encode(response)
+}
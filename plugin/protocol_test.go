@@ -0,0 +1,78 @@
+package plugin_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/plugin"
+)
+
+var _ = Describe("Serve", func() {
+	var (
+		reader *strings.Reader
+		writer *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		reader = strings.NewReader(`{"version": 1, "generator": "example", "options": {"file": "api.txt"}, "spec": {"types": [], "controllers": []}}`)
+		writer = &bytes.Buffer{}
+	})
+
+	It("writes the files of the handler", func() {
+		handler := func(request *plugin.Request) ([]*plugin.File, error) {
+			Expect(request.Generator).To(Equal("example"))
+			Expect(request.Options).To(HaveKeyWithValue("file", "api.txt"))
+			Expect(request.Spec.Types).To(BeEmpty())
+
+			return []*plugin.File{{Name: "api.txt", Content: "api"}}, nil
+		}
+
+		Expect(plugin.Serve(reader, writer, handler)).To(Succeed())
+		Expect(writer.String()).To(MatchJSON(`{"version": 1, "files": [{"name": "api.txt", "content": "api"}]}`))
+	})
+
+	Context("when the handler fails", func() {
+		It("writes the error", func() {
+			handler := func(request *plugin.Request) ([]*plugin.File, error) {
+				return nil, fmt.Errorf("oh no")
+			}
+
+			Expect(plugin.Serve(reader, writer, handler)).To(Succeed())
+			Expect(writer.String()).To(MatchJSON(`{"version": 1, "error": "oh no", "files": []}`))
+		})
+	})
+
+	Context("when the protocol version is not supported", func() {
+		BeforeEach(func() {
+			reader = strings.NewReader(`{"version": 2}`)
+		})
+
+		It("writes the error", func() {
+			handler := func(request *plugin.Request) ([]*plugin.File, error) {
+				Fail("the handler should not be called")
+				return nil, nil
+			}
+
+			Expect(plugin.Serve(reader, writer, handler)).To(Succeed())
+			Expect(writer.String()).To(MatchJSON(`{"version": 1, "error": "unsupported protocol version: 2", "files": []}`))
+		})
+	})
+
+	Context("when the request is not valid", func() {
+		BeforeEach(func() {
+			reader = strings.NewReader(`{`)
+		})
+
+		It("returns an error", func() {
+			handler := func(request *plugin.Request) ([]*plugin.File, error) {
+				return nil, nil
+			}
+
+			Expect(plugin.Serve(reader, writer, handler)).To(MatchError("unexpected EOF"))
+		})
+	})
+})
@@ -0,0 +1,787 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/phogolabs/stride/codedom"
+)
+
+// The kinds of the types
+const (
+	KindAny       = "any"
+	KindPrimitive = "primitive"
+	KindAlias     = "alias"
+	KindArray     = "array"
+	KindMap       = "map"
+	KindEnum      = "enum"
+	KindUnion     = "union"
+	KindClass     = "class"
+)
+
+// Spec is the document of the resolved specification
+type Spec struct {
+	Info            *Info             `json:"info,omitempty"`
+	Types           []*Type           `json:"types"`
+	Controllers     []*Controller     `json:"controllers"`
+	SecuritySchemes []*SecurityScheme `json:"security_schemes,omitempty"`
+}
+
+// Info is the information of the specification
+type Info struct {
+	Title          string `json:"title,omitempty"`
+	Description    string `json:"description,omitempty"`
+	TermsOfService string `json:"terms_of_service,omitempty"`
+	Version        string `json:"version,omitempty"`
+}
+
+// SecurityScheme is a security scheme. The schemes of an operation have the
+// scopes required by the operation.
+type SecurityScheme struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	Type         string   `json:"type"`
+	In           string   `json:"in,omitempty"`
+	Key          string   `json:"key,omitempty"`
+	Scheme       string   `json:"scheme,omitempty"`
+	BearerFormat string   `json:"bearer_format,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+}
+
+// Type is a type. The declared types are referred by name with $ref.
+type Type struct {
+	Ref           string        `json:"$ref,omitempty"`
+	Name          string        `json:"name,omitempty"`
+	Kind          string        `json:"kind,omitempty"`
+	Description   string        `json:"description,omitempty"`
+	Nullable      bool          `json:"nullable,omitempty"`
+	Key           *Type         `json:"key,omitempty"`
+	Element       *Type         `json:"element,omitempty"`
+	Default       interface{}   `json:"default,omitempty"`
+	Example       interface{}   `json:"example,omitempty"`
+	Min           *float64      `json:"min,omitempty"`
+	Max           *float64      `json:"max,omitempty"`
+	MinExclusive  bool          `json:"min_exclusive,omitempty"`
+	MaxExclusive  bool          `json:"max_exclusive,omitempty"`
+	MultipleOf    *float64      `json:"multiple_of,omitempty"`
+	Pattern       string        `json:"pattern,omitempty"`
+	Unique        bool          `json:"unique,omitempty"`
+	Values        []interface{} `json:"values,omitempty"`
+	Discriminator string        `json:"discriminator,omitempty"`
+	Properties    []*Property   `json:"properties,omitempty"`
+}
+
+// Property is a property of a class or a variant of a union
+type Property struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	ReadOnly    bool   `json:"read_only,omitempty"`
+	WriteOnly   bool   `json:"write_only,omitempty"`
+	Embedded    bool   `json:"embedded,omitempty"`
+	Type        *Type  `json:"type"`
+}
+
+// Controller is a group of operations
+type Controller struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Operations  []*Operation `json:"operations"`
+}
+
+// Operation is an operation
+type Operation struct {
+	Method      string               `json:"method"`
+	Path        string               `json:"path"`
+	Name        string               `json:"name"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Deprecated  bool                 `json:"deprecated,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Requests    []*OperationRequest  `json:"requests"`
+	Responses   []*OperationResponse `json:"responses"`
+	// Security are the alternative security requirements of the operation
+	Security [][]*SecurityScheme `json:"security,omitempty"`
+	// Callbacks are the requests that the operation sends to the subscribers
+	Callbacks []*Callback `json:"callbacks,omitempty"`
+}
+
+// Callback is a group of requests sent to the subscribers. The path of its
+// operations is the runtime expression of the subscriber url.
+type Callback struct {
+	Name       string       `json:"name"`
+	Operations []*Operation `json:"operations"`
+}
+
+// OperationRequest is a request of an operation
+type OperationRequest struct {
+	ContentType string       `json:"content_type,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Required    bool         `json:"required,omitempty"`
+	Example     interface{}  `json:"example,omitempty"`
+	Parameters  []*Parameter `json:"parameters"`
+	Type        *Type        `json:"type,omitempty"`
+	// Encoding maps the properties of a multipart body to their content types
+	Encoding map[string]string `json:"encoding,omitempty"`
+}
+
+// OperationResponse is a response of an operation
+type OperationResponse struct {
+	Code        int          `json:"code"`
+	Default     bool         `json:"default,omitempty"`
+	Description string       `json:"description,omitempty"`
+	ContentType string       `json:"content_type,omitempty"`
+	Example     interface{}  `json:"example,omitempty"`
+	Parameters  []*Parameter `json:"parameters"`
+	Links       []*Link      `json:"links,omitempty"`
+	Type        *Type        `json:"type,omitempty"`
+}
+
+// Link is a link from a response to the operation of a controller
+type Link struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	OperationID  string `json:"operation_id,omitempty"`
+	OperationRef string `json:"operation_ref,omitempty"`
+	// Controller and Operation are the names of the target operation and its controller
+	Controller  string            `json:"controller"`
+	Operation   string            `json:"operation"`
+	Parameters  map[string]string `json:"parameters,omitempty"`
+	RequestBody string            `json:"request_body,omitempty"`
+}
+
+// Parameter is a parameter of a request or a header of a response
+type Parameter struct {
+	Name        string `json:"name"`
+	In          string `json:"in"`
+	Description string `json:"description,omitempty"`
+	Style       string `json:"style,omitempty"`
+	Explode     bool   `json:"explode,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Deprecated  bool   `json:"deprecated,omitempty"`
+	Type        *Type  `json:"type"`
+}
+
+// Encode encodes the spec descriptor
+func Encode(spec *codedom.SpecDescriptor) *Spec {
+	encoder := &encoder{
+		declared: map[*codedom.TypeDescriptor]bool{},
+		visiting: map[*codedom.TypeDescriptor]bool{},
+	}
+
+	return encoder.spec(spec)
+}
+
+// Decode decodes the spec descriptor
+func Decode(spec *Spec) (*codedom.SpecDescriptor, error) {
+	decoder := &decoder{
+		declared: map[string]*codedom.TypeDescriptor{},
+	}
+
+	return decoder.spec(spec)
+}
+
+type encoder struct {
+	declared map[*codedom.TypeDescriptor]bool
+	visiting map[*codedom.TypeDescriptor]bool
+}
+
+func (e *encoder) spec(spec *codedom.SpecDescriptor) *Spec {
+	document := &Spec{
+		Types:       []*Type{},
+		Controllers: []*Controller{},
+	}
+
+	if info := spec.Info; info != nil {
+		document.Info = &Info{
+			Title:          info.Title,
+			Description:    info.Description,
+			TermsOfService: info.TermsOfService,
+			Version:        info.Version,
+		}
+	}
+
+	for _, descriptor := range spec.Types {
+		e.declared[descriptor] = true
+	}
+
+	for _, descriptor := range spec.Types {
+		document.Types = append(document.Types, e.declaration(descriptor))
+	}
+
+	for _, descriptor := range spec.Controllers {
+		controller := &Controller{
+			Name:        descriptor.Name,
+			Description: descriptor.Description,
+			Operations:  []*Operation{},
+		}
+
+		for _, operation := range descriptor.Operations {
+			controller.Operations = append(controller.Operations, e.operation(operation))
+		}
+
+		document.Controllers = append(document.Controllers, controller)
+	}
+
+	document.SecuritySchemes = e.schemes(spec.SecuritySchemes)
+	return document
+}
+
+func (e *encoder) operation(descriptor *codedom.OperationDescriptor) *Operation {
+	operation := &Operation{
+		Method:      descriptor.Method,
+		Path:        descriptor.Path,
+		Name:        descriptor.Name,
+		Summary:     descriptor.Summary,
+		Description: descriptor.Description,
+		Deprecated:  descriptor.Deprecated,
+		Tags:        descriptor.Tags,
+		Requests:    []*OperationRequest{},
+		Responses:   []*OperationResponse{},
+	}
+
+	for _, request := range descriptor.Requests {
+		operation.Requests = append(operation.Requests, &OperationRequest{
+			ContentType: request.ContentType,
+			Description: request.Description,
+			Required:    request.Required,
+			Example:     request.Example,
+			Parameters:  e.parameters(request.Parameters),
+			Type:        e.reference(request.RequestType),
+			Encoding:    request.Encoding,
+		})
+	}
+
+	for _, response := range descriptor.Responses {
+		operation.Responses = append(operation.Responses, &OperationResponse{
+			Code:        response.Code,
+			Default:     response.IsDefault,
+			Description: response.Description,
+			ContentType: response.ContentType,
+			Example:     response.Example,
+			Parameters:  e.parameters(response.Parameters),
+			Links:       e.links(response.Links),
+			Type:        e.reference(response.ResponseType),
+		})
+	}
+
+	for _, requirement := range descriptor.Security {
+		operation.Security = append(operation.Security, e.schemes(requirement))
+	}
+
+	for _, callback := range descriptor.Callbacks {
+		item := &Callback{
+			Name:       callback.Name,
+			Operations: []*Operation{},
+		}
+
+		for _, descriptor := range callback.Operations {
+			item.Operations = append(item.Operations, e.operation(descriptor))
+		}
+
+		operation.Callbacks = append(operation.Callbacks, item)
+	}
+
+	return operation
+}
+
+func (e *encoder) links(descriptors codedom.LinkDescriptorCollection) []*Link {
+	var links []*Link
+
+	for _, descriptor := range descriptors {
+		link := &Link{
+			Name:         descriptor.Name,
+			Description:  descriptor.Description,
+			OperationID:  descriptor.OperationID,
+			OperationRef: descriptor.OperationRef,
+			Controller:   descriptor.Controller,
+			Parameters:   descriptor.Parameters,
+			RequestBody:  descriptor.RequestBody,
+		}
+
+		if operation := descriptor.Operation; operation != nil {
+			link.Operation = operation.Name
+		}
+
+		links = append(links, link)
+	}
+
+	return links
+}
+
+func (e *encoder) schemes(descriptors codedom.SecuritySchemeDescriptorCollection) []*SecurityScheme {
+	schemes := []*SecurityScheme{}
+
+	for _, descriptor := range descriptors {
+		schemes = append(schemes, &SecurityScheme{
+			Name:         descriptor.Name,
+			Description:  descriptor.Description,
+			Type:         descriptor.Type,
+			In:           descriptor.In,
+			Key:          descriptor.Key,
+			Scheme:       descriptor.Scheme,
+			BearerFormat: descriptor.BearerFormat,
+			Scopes:       descriptor.Scopes,
+		})
+	}
+
+	return schemes
+}
+
+func (e *encoder) parameters(descriptors codedom.ParameterDescriptorCollection) []*Parameter {
+	parameters := []*Parameter{}
+
+	for _, descriptor := range descriptors {
+		parameters = append(parameters, &Parameter{
+			Name:        descriptor.Name,
+			In:          descriptor.In,
+			Description: descriptor.Description,
+			Style:       descriptor.Style,
+			Explode:     descriptor.Explode,
+			Required:    descriptor.Required,
+			Deprecated:  descriptor.Deprecated,
+			Type:        e.reference(descriptor.ParameterType),
+		})
+	}
+
+	return parameters
+}
+
+// reference encodes a type that is used by another type or an operation
+func (e *encoder) reference(descriptor *codedom.TypeDescriptor) *Type {
+	if descriptor == nil {
+		return nil
+	}
+
+	// the recursive types are referred by name
+	if e.declared[descriptor] || e.visiting[descriptor] {
+		return &Type{Ref: descriptor.Name}
+	}
+
+	return e.declaration(descriptor)
+}
+
+func (e *encoder) declaration(descriptor *codedom.TypeDescriptor) *Type {
+	e.visiting[descriptor] = true
+	defer delete(e.visiting, descriptor)
+
+	var (
+		metadata = descriptor.Metadata
+		kind     = &Type{
+			Name:        descriptor.Name,
+			Kind:        e.kind(descriptor),
+			Description: descriptor.Description,
+			Nullable:    descriptor.IsNullable,
+			Key:         e.reference(descriptor.Key),
+			Element:     e.reference(descriptor.Element),
+			Default:     descriptor.Default,
+			Example:     descriptor.Example,
+		}
+	)
+
+	number := func(key string) *float64 {
+		if value, ok := metadata[key].(*float64); ok && value != nil {
+			item := *value
+			return &item
+		}
+
+		return nil
+	}
+
+	kind.Min = number("min")
+	kind.Max = number("max")
+	kind.MultipleOf = number("multiple_of")
+	kind.MinExclusive, _ = metadata["min_exclusive"].(bool)
+	kind.MaxExclusive, _ = metadata["max_exclusive"].(bool)
+	kind.Pattern, _ = metadata["pattern"].(string)
+	kind.Unique, _ = metadata["unique"].(bool)
+	kind.Values, _ = metadata["values"].([]interface{})
+	kind.Discriminator, _ = metadata["discriminator"].(string)
+
+	for _, property := range descriptor.Properties {
+		kind.Properties = append(kind.Properties, &Property{
+			Name:        property.Name,
+			Description: property.Description,
+			Required:    property.Required,
+			ReadOnly:    property.ReadOnly,
+			WriteOnly:   property.WriteOnly,
+			Embedded:    property.IsEmbedded,
+			Type:        e.reference(property.PropertyType),
+		})
+	}
+
+	return kind
+}
+
+func (e *encoder) kind(descriptor *codedom.TypeDescriptor) string {
+	switch {
+	case descriptor.IsAlias:
+		return KindAlias
+	case descriptor.IsArray:
+		return KindArray
+	case descriptor.IsMap:
+		return KindMap
+	case descriptor.IsEnum:
+		return KindEnum
+	case descriptor.IsUnion:
+		return KindUnion
+	case descriptor.IsClass:
+		return KindClass
+	case descriptor.IsPrimitive:
+		return KindPrimitive
+	case descriptor.IsAny:
+		return KindAny
+	default:
+		return ""
+	}
+}
+
+type decoder struct {
+	declared map[string]*codedom.TypeDescriptor
+	// targets are the decoded links and the names of their target operations
+	targets []*target
+}
+
+type target struct {
+	link      *codedom.LinkDescriptor
+	operation string
+}
+
+func (d *decoder) spec(document *Spec) (*codedom.SpecDescriptor, error) {
+	spec := &codedom.SpecDescriptor{
+		Types:       codedom.TypeDescriptorCollection{},
+		Controllers: codedom.ControllerDescriptorCollection{},
+	}
+
+	if info := document.Info; info != nil {
+		spec.Info = &codedom.InfoDescriptor{
+			Title:          info.Title,
+			Description:    info.Description,
+			TermsOfService: info.TermsOfService,
+			Version:        info.Version,
+		}
+	}
+
+	// the declared types are allocated first, because they can refer to each other
+	for _, kind := range document.Types {
+		descriptor := &codedom.TypeDescriptor{Name: kind.Name}
+		d.declared[kind.Name] = descriptor
+		spec.Types = append(spec.Types, descriptor)
+	}
+
+	for index, kind := range document.Types {
+		if err := d.fill(spec.Types[index], kind); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, controller := range document.Controllers {
+		descriptor := &codedom.ControllerDescriptor{
+			Name:        controller.Name,
+			Description: controller.Description,
+			Operations:  codedom.OperationDescriptorCollection{},
+		}
+
+		for _, operation := range controller.Operations {
+			item, err := d.operation(operation)
+			if err != nil {
+				return nil, err
+			}
+
+			descriptor.Operations = append(descriptor.Operations, item)
+		}
+
+		spec.Controllers = append(spec.Controllers, descriptor)
+	}
+
+	spec.SecuritySchemes = d.schemes(document.SecuritySchemes)
+
+	// the links are resolved after all operations are decoded
+	if err := d.navigate(spec.Controllers); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+func (d *decoder) navigate(controllers codedom.ControllerDescriptorCollection) error {
+	targets := map[string]*codedom.OperationDescriptor{}
+
+	for _, controller := range controllers {
+		for _, operation := range controller.Operations {
+			targets[controller.Name+"/"+operation.Name] = operation
+		}
+	}
+
+	for _, item := range d.targets {
+		operation, ok := targets[item.link.Controller+"/"+item.operation]
+		if !ok {
+			return fmt.Errorf("unknown link operation: %v of controller: %v", item.operation, item.link.Controller)
+		}
+
+		item.link.Operation = operation
+	}
+
+	return nil
+}
+
+func (d *decoder) operation(operation *Operation) (*codedom.OperationDescriptor, error) {
+	descriptor := &codedom.OperationDescriptor{
+		Method:      operation.Method,
+		Path:        operation.Path,
+		Name:        operation.Name,
+		Summary:     operation.Summary,
+		Description: operation.Description,
+		Deprecated:  operation.Deprecated,
+		Tags:        operation.Tags,
+		Requests:    codedom.RequestDescriptorCollection{},
+		Responses:   codedom.ResponseDescriptorCollection{},
+	}
+
+	for _, request := range operation.Requests {
+		parameters, err := d.parameters(request.Parameters)
+		if err != nil {
+			return nil, err
+		}
+
+		kind, err := d.reference(request.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		descriptor.Requests = append(descriptor.Requests, &codedom.RequestDescriptor{
+			ContentType: request.ContentType,
+			Description: request.Description,
+			Required:    request.Required,
+			Example:     request.Example,
+			Parameters:  parameters,
+			RequestType: kind,
+			Encoding:    request.Encoding,
+		})
+	}
+
+	for _, response := range operation.Responses {
+		parameters, err := d.parameters(response.Parameters)
+		if err != nil {
+			return nil, err
+		}
+
+		kind, err := d.reference(response.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		descriptor.Responses = append(descriptor.Responses, &codedom.ResponseDescriptor{
+			Code:         response.Code,
+			IsDefault:    response.Default,
+			Description:  response.Description,
+			ContentType:  response.ContentType,
+			Example:      response.Example,
+			Parameters:   parameters,
+			Links:        d.links(response.Links),
+			ResponseType: kind,
+		})
+	}
+
+	for _, requirement := range operation.Security {
+		descriptor.Security = append(descriptor.Security, d.schemes(requirement))
+	}
+
+	for _, callback := range operation.Callbacks {
+		item := &codedom.CallbackDescriptor{
+			Name:       callback.Name,
+			Operations: codedom.OperationDescriptorCollection{},
+		}
+
+		for _, operation := range callback.Operations {
+			child, err := d.operation(operation)
+			if err != nil {
+				return nil, err
+			}
+
+			item.Operations = append(item.Operations, child)
+		}
+
+		descriptor.Callbacks = append(descriptor.Callbacks, item)
+	}
+
+	return descriptor, nil
+}
+
+func (d *decoder) links(links []*Link) codedom.LinkDescriptorCollection {
+	var descriptors codedom.LinkDescriptorCollection
+
+	for _, link := range links {
+		descriptor := &codedom.LinkDescriptor{
+			Name:         link.Name,
+			Description:  link.Description,
+			OperationID:  link.OperationID,
+			OperationRef: link.OperationRef,
+			Controller:   link.Controller,
+			Parameters:   link.Parameters,
+			RequestBody:  link.RequestBody,
+		}
+
+		d.targets = append(d.targets, &target{
+			link:      descriptor,
+			operation: link.Operation,
+		})
+		descriptors = append(descriptors, descriptor)
+	}
+
+	return descriptors
+}
+
+func (d *decoder) schemes(schemes []*SecurityScheme) codedom.SecuritySchemeDescriptorCollection {
+	descriptors := codedom.SecuritySchemeDescriptorCollection{}
+
+	for _, scheme := range schemes {
+		descriptors = append(descriptors, &codedom.SecuritySchemeDescriptor{
+			Name:         scheme.Name,
+			Description:  scheme.Description,
+			Type:         scheme.Type,
+			In:           scheme.In,
+			Key:          scheme.Key,
+			Scheme:       scheme.Scheme,
+			BearerFormat: scheme.BearerFormat,
+			Scopes:       scheme.Scopes,
+		})
+	}
+
+	return descriptors
+}
+
+func (d *decoder) parameters(parameters []*Parameter) (codedom.ParameterDescriptorCollection, error) {
+	descriptors := codedom.ParameterDescriptorCollection{}
+
+	for _, parameter := range parameters {
+		kind, err := d.reference(parameter.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		descriptors = append(descriptors, &codedom.ParameterDescriptor{
+			Name:          parameter.Name,
+			In:            parameter.In,
+			Description:   parameter.Description,
+			Style:         parameter.Style,
+			Explode:       parameter.Explode,
+			Required:      parameter.Required,
+			Deprecated:    parameter.Deprecated,
+			ParameterType: kind,
+		})
+	}
+
+	return descriptors, nil
+}
+
+func (d *decoder) reference(kind *Type) (*codedom.TypeDescriptor, error) {
+	if kind == nil {
+		return nil, nil
+	}
+
+	if kind.Ref != "" {
+		descriptor, ok := d.declared[kind.Ref]
+		if !ok {
+			return nil, fmt.Errorf("unknown type reference: %v", kind.Ref)
+		}
+
+		return descriptor, nil
+	}
+
+	descriptor := &codedom.TypeDescriptor{}
+
+	if err := d.fill(descriptor, kind); err != nil {
+		return nil, err
+	}
+
+	return descriptor, nil
+}
+
+func (d *decoder) fill(descriptor *codedom.TypeDescriptor, kind *Type) error {
+	var err error
+
+	descriptor.Name = kind.Name
+	descriptor.Description = kind.Description
+	descriptor.IsNullable = kind.Nullable
+	descriptor.Default = kind.Default
+	descriptor.Example = kind.Example
+
+	switch kind.Kind {
+	case KindAny:
+		descriptor.IsAny = true
+	case KindPrimitive:
+		descriptor.IsPrimitive = true
+	case KindAlias:
+		descriptor.IsAlias = true
+	case KindArray:
+		descriptor.IsArray = true
+	case KindMap:
+		descriptor.IsMap = true
+	case KindEnum:
+		descriptor.IsEnum = true
+	case KindUnion:
+		descriptor.IsUnion = true
+	case KindClass:
+		descriptor.IsClass = true
+	case "":
+	default:
+		return fmt.Errorf("unknown kind: %v of type: %v", kind.Kind, kind.Name)
+	}
+
+	if descriptor.Key, err = d.reference(kind.Key); err != nil {
+		return err
+	}
+
+	if descriptor.Element, err = d.reference(kind.Element); err != nil {
+		return err
+	}
+
+	descriptor.Metadata = d.metadata(kind)
+
+	for _, property := range kind.Properties {
+		item, err := d.reference(property.Type)
+		if err != nil {
+			return err
+		}
+
+		descriptor.Properties = append(descriptor.Properties, &codedom.PropertyDescriptor{
+			Name:         property.Name,
+			Description:  property.Description,
+			Required:     property.Required,
+			ReadOnly:     property.ReadOnly,
+			WriteOnly:    property.WriteOnly,
+			IsEmbedded:   property.Embedded,
+			PropertyType: item,
+		})
+	}
+
+	return nil
+}
+
+// metadata returns the metadata in the form created by codedom.Resolver
+func (d *decoder) metadata(kind *Type) codedom.Metadata {
+	metadata := codedom.Metadata{}
+
+	switch kind.Kind {
+	case KindUnion:
+		metadata["discriminator"] = kind.Discriminator
+	case KindEnum:
+		metadata["values"] = kind.Values
+	case KindArray, KindMap, KindPrimitive:
+		metadata["min"] = kind.Min
+		metadata["max"] = kind.Max
+		metadata["min_exclusive"] = kind.MinExclusive
+		metadata["max_exclusive"] = kind.MaxExclusive
+
+		if kind.Kind == KindArray {
+			metadata["unique"] = kind.Unique
+		}
+
+		if kind.Kind == KindPrimitive {
+			metadata["pattern"] = kind.Pattern
+			metadata["multiple_of"] = kind.MultipleOf
+		}
+	}
+
+	return metadata
+}
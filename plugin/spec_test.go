@@ -0,0 +1,241 @@
+package plugin_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/plugin"
+)
+
+var _ = Describe("Spec", func() {
+	marshal := func(spec *plugin.Spec) string {
+		data, err := json.Marshal(spec)
+		Expect(err).To(BeNil())
+		return string(data)
+	}
+
+	unmarshal := func(data string) *plugin.Spec {
+		spec := &plugin.Spec{}
+		Expect(json.Unmarshal([]byte(data), spec)).To(Succeed())
+		return spec
+	}
+
+	It("encodes and decodes the spec", func() {
+		var (
+			spec     = resolve("../fixture/spec/web-api.yaml")
+			document = marshal(plugin.Encode(spec))
+		)
+
+		decoded, err := plugin.Decode(unmarshal(document))
+		Expect(err).To(BeNil())
+		Expect(decoded.Types).To(HaveLen(len(spec.Types)))
+		Expect(decoded.Controllers).To(HaveLen(len(spec.Controllers)))
+		Expect(marshal(plugin.Encode(decoded))).To(MatchJSON(document))
+	})
+
+	It("encodes and decodes the security schemes", func() {
+		var (
+			spec     = resolve("../fixture/spec/security.yaml")
+			document = marshal(plugin.Encode(spec))
+		)
+
+		decoded, err := plugin.Decode(unmarshal(document))
+		Expect(err).To(BeNil())
+		Expect(decoded.SecuritySchemes).To(HaveLen(len(spec.SecuritySchemes)))
+		Expect(marshal(plugin.Encode(decoded))).To(MatchJSON(document))
+
+		for _, operation := range decoded.Controllers[0].Operations {
+			if operation.Name != "create-pet" {
+				continue
+			}
+
+			Expect(operation.Security).To(HaveLen(1))
+			Expect(operation.Security[0]).To(HaveLen(1))
+			Expect(operation.Security[0][0].Name).To(Equal("PetStoreAuth"))
+			Expect(operation.Security[0][0].Type).To(Equal("oauth2"))
+			Expect(operation.Security[0][0].Scopes).To(ConsistOf("write:pets", "read:pets"))
+		}
+	})
+
+	It("encodes and decodes the callbacks", func() {
+		var (
+			spec     = resolve("../fixture/spec/callbacks.yaml")
+			document = marshal(plugin.Encode(spec))
+		)
+
+		decoded, err := plugin.Decode(unmarshal(document))
+		Expect(err).To(BeNil())
+		Expect(marshal(plugin.Encode(decoded))).To(MatchJSON(document))
+
+		operation := decoded.Controllers[0].Operations[0]
+		Expect(operation.Name).To(Equal("create-subscription"))
+		Expect(operation.Callbacks).To(HaveLen(2))
+
+		callback := operation.Callbacks[1]
+		Expect(callback.Name).To(Equal("onEvent"))
+		Expect(callback.Operations).To(HaveLen(1))
+		Expect(callback.Operations[0].Path).To(Equal("{$request.body#/callbackUrl}"))
+		Expect(callback.Operations[0].Requests[0].RequestType).To(BeIdenticalTo(decoded.Types[0]))
+	})
+
+	It("encodes and decodes the links", func() {
+		var (
+			spec     = resolve("../fixture/spec/links.yaml")
+			document = marshal(plugin.Encode(spec))
+		)
+
+		decoded, err := plugin.Decode(unmarshal(document))
+		Expect(err).To(BeNil())
+		Expect(marshal(plugin.Encode(decoded))).To(MatchJSON(document))
+
+		operations := map[string]*codedom.OperationDescriptor{}
+
+		for _, controller := range decoded.Controllers {
+			for _, operation := range controller.Operations {
+				operations[controller.Name+"/"+operation.Name] = operation
+			}
+		}
+
+		count := 0
+
+		for _, operation := range operations {
+			for _, response := range operation.Responses {
+				for _, link := range response.Links {
+					Expect(link.Operation).To(BeIdenticalTo(operations[link.Controller+"/"+link.Operation.Name]))
+					count++
+				}
+			}
+		}
+
+		Expect(count).To(BeNumerically(">", 0))
+	})
+
+	It("encodes and decodes the encoding of the requests", func() {
+		var (
+			spec     = resolve("../fixture/spec/requests-multipart.yaml")
+			document = marshal(plugin.Encode(spec))
+		)
+
+		decoded, err := plugin.Decode(unmarshal(document))
+		Expect(err).To(BeNil())
+		Expect(marshal(plugin.Encode(decoded))).To(MatchJSON(document))
+
+		operation := decoded.Controllers[0].Operations[1]
+		Expect(operation.Name).To(Equal("upload-document"))
+
+		request := operation.Requests[0]
+		Expect(request.ContentType).To(Equal("multipart/form-data"))
+		Expect(request.Encoding).To(HaveKeyWithValue("file", "application/pdf"))
+		Expect(request.Encoding).To(HaveKeyWithValue("metadata", "application/json"))
+	})
+
+	It("refers to the declared types by name", func() {
+		var (
+			spec     = resolve("../fixture/spec/web-api.yaml")
+			document = plugin.Encode(spec)
+		)
+
+		for _, kind := range document.Types {
+			if kind.Name != "account" {
+				continue
+			}
+
+			for _, property := range kind.Properties {
+				if property.Name == "payment" {
+					Expect(property.Type.Ref).To(Equal("payment"))
+					Expect(property.Type.Kind).To(BeEmpty())
+				}
+			}
+		}
+	})
+
+	It("restores the metadata", func() {
+		var (
+			min  = 2.0
+			spec = &codedom.SpecDescriptor{
+				Types: codedom.TypeDescriptorCollection{
+					&codedom.TypeDescriptor{
+						Name:        "name",
+						IsPrimitive: true,
+						Metadata: codedom.Metadata{
+							"min":     &min,
+							"pattern": "^[a-z]+$",
+						},
+					},
+				},
+			}
+		)
+
+		decoded, err := plugin.Decode(unmarshal(marshal(plugin.Encode(spec))))
+		Expect(err).To(BeNil())
+
+		descriptor := decoded.Types[0]
+		Expect(descriptor.IsPrimitive).To(BeTrue())
+		Expect(descriptor.Metadata["min"]).To(Equal(&min))
+		Expect(descriptor.Metadata["max"]).To(BeNil())
+		Expect(descriptor.Metadata.HasPattern()).To(BeTrue())
+	})
+
+	Context("when the type is recursive", func() {
+		It("encodes and decodes the spec", func() {
+			node := &codedom.TypeDescriptor{
+				Name:    "node",
+				IsClass: true,
+			}
+
+			node.Properties = codedom.PropertyDescriptorCollection{
+				&codedom.PropertyDescriptor{
+					Name: "children",
+					PropertyType: &codedom.TypeDescriptor{
+						Name:    "array",
+						IsArray: true,
+						Element: node,
+					},
+				},
+			}
+
+			spec := &codedom.SpecDescriptor{
+				Types: codedom.TypeDescriptorCollection{node},
+			}
+
+			document := plugin.Encode(spec)
+			Expect(document.Types[0].Properties[0].Type.Element.Ref).To(Equal("node"))
+
+			decoded, err := plugin.Decode(unmarshal(marshal(document)))
+			Expect(err).To(BeNil())
+
+			descriptor := decoded.Types[0]
+			Expect(descriptor.Properties[0].PropertyType.Element).To(BeIdenticalTo(descriptor))
+		})
+	})
+
+	Context("when the type reference is unknown", func() {
+		It("returns an error", func() {
+			document := unmarshal(`{"types":[{"name":"account","kind":"class","properties":[{"name":"owner","type":{"$ref":"user"}}]}]}`)
+
+			_, err := plugin.Decode(document)
+			Expect(err).To(MatchError("unknown type reference: user"))
+		})
+	})
+
+	Context("when the link operation is unknown", func() {
+		It("returns an error", func() {
+			document := unmarshal(`{"types":[],"controllers":[{"name":"user","operations":[{"method":"GET","path":"/users","name":"get-users","requests":[],"responses":[{"code":200,"parameters":[],"links":[{"name":"GetUser","controller":"user","operation":"get-user"}]}]}]}]}`)
+
+			_, err := plugin.Decode(document)
+			Expect(err).To(MatchError("unknown link operation: get-user of controller: user"))
+		})
+	})
+
+	Context("when the kind is unknown", func() {
+		It("returns an error", func() {
+			document := unmarshal(`{"types":[{"name":"account","kind":"struct"}]}`)
+
+			_, err := plugin.Decode(document)
+			Expect(err).To(MatchError("unknown kind: struct of type: account"))
+		})
+	})
+})
@@ -0,0 +1,64 @@
+package plugin_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+)
+
+// example is the path to the example plugin
+var example string
+
+func TestPlugin(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Plugin Suite")
+}
+
+var _ = BeforeSuite(func() {
+	var err error
+
+	example, err = gexec.Build("github.com/phogolabs/stride/cmd/stride-gen-example")
+	Expect(err).To(BeNil())
+})
+
+var _ = AfterSuite(func() {
+	gexec.CleanupBuildArtifacts()
+})
+
+func tmpdir() string {
+	dir, err := ioutil.TempDir("", "example")
+	Expect(err).To(BeNil())
+	return dir
+}
+
+// script creates an executable shell script in the directory
+func script(dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	Expect(ioutil.WriteFile(path, []byte("#!/bin/sh\n"+content), 0755)).To(Succeed())
+	return path
+}
+
+func resolve(path string) *codedom.SpecDescriptor {
+	reporter := &fake.Reporter{}
+	reporter.WithReturns(reporter)
+
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromFile(path)
+	Expect(err).To(BeNil())
+
+	resolver := &codedom.Resolver{
+		Reporter: reporter,
+		Cache:    codedom.TypeDescriptorMap{},
+	}
+
+	spec, err := resolver.Resolve(swagger)
+	Expect(err).To(BeNil())
+	return spec
+}
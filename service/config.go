@@ -0,0 +1,141 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/phogolabs/stride/codedom"
+)
+
+// ConfigFile is the name of the project configuration file
+const ConfigFile = "stride.yaml"
+
+// Config is the configuration of a project
+type Config struct {
+	// Spec is the source of the specification. It can be a path relative to
+	// the project directory or any URL supported by go-getter.
+	Spec string `json:"spec"`
+	// Generators are the names of the generators that should run
+	Generators []string `json:"generators"`
+	// Options are the options of the generators by generator name
+	Options map[string]map[string]interface{} `json:"options"`
+	// Layout is the layout of the golang packages
+	Layout LayoutConfig `json:"layout"`
+	// Types maps the primitive types in the form <type>[/<format>] to golang types
+	Types map[string]string `json:"types"`
+	// Names overrides the names of the types, controllers and operations
+	Names NamesConfig `json:"names"`
+}
+
+// LayoutConfig is the layout of the golang packages
+type LayoutConfig struct {
+	Module   string `json:"module"`
+	Package  string `json:"package"`
+	Models   string `json:"models"`
+	Handlers string `json:"handlers"`
+	Command  string `json:"command"`
+}
+
+// NamesConfig overrides the names of the resolved descriptors
+type NamesConfig struct {
+	Types       map[string]string `json:"types"`
+	Controllers map[string]string `json:"controllers"`
+	Operations  map[string]string `json:"operations"`
+}
+
+// LoadConfig loads the configuration file from the project directory. It
+// returns an empty configuration if the file does not exist.
+func LoadConfig(dir string) (*Config, error) {
+	config := &Config{}
+
+	name := filepath.Join(dir, ConfigFile)
+
+	data, err := ioutil.ReadFile(name)
+	if os.IsNotExist(err) {
+		return config, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if data, err = yaml.YAMLToJSON(data); err != nil {
+		return nil, fmt.Errorf("invalid config: %v: %v", name, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// report the misspelled keys
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(config); err != nil {
+		return nil, fmt.Errorf("invalid config: %v: %v", name, err)
+	}
+
+	// the local spec is relative to the project directory
+	if spec := config.Spec; spec != "" && !filepath.IsAbs(spec) && !strings.Contains(spec, "::") && !strings.Contains(spec, "://") {
+		if _, err := os.Stat(filepath.Join(dir, spec)); err == nil {
+			config.Spec = filepath.Join(dir, spec)
+		}
+	}
+
+	return config, nil
+}
+
+// Arguments returns the layout and the options of the generators in the form
+// <generator>.<name>=<value>
+func (c *Config) Arguments() []string {
+	args := []string{}
+
+	layout := []struct {
+		Generator string
+		Name      string
+		Value     string
+	}{
+		{"golang", "module", c.Layout.Module},
+		{"markdown", "module", c.Layout.Module},
+		{"golang", "package", c.Layout.Package},
+		{"golang", "models", c.Layout.Models},
+		{"golang", "handlers", c.Layout.Handlers},
+		{"golang", "command", c.Layout.Command},
+	}
+
+	for _, option := range layout {
+		if option.Value != "" {
+			args = append(args, fmt.Sprintf("%s.%s=%s", option.Generator, option.Name, option.Value))
+		}
+	}
+
+	options := []string{}
+
+	for generator, values := range c.Options {
+		for name, value := range values {
+			options = append(options, fmt.Sprintf("%s.%s=%v", generator, name, value))
+		}
+	}
+
+	// the options are passed in the same order every time
+	sort.Strings(options)
+
+	return append(args, options...)
+}
+
+// TypeMapping returns the mapping of the primitive types
+func (c *Config) TypeMapping() codedom.TypeMapping {
+	return codedom.TypeMapping(c.Types)
+}
+
+// NameMapping returns the name overrides
+func (c *Config) NameMapping() *codedom.NameMapping {
+	return &codedom.NameMapping{
+		Types:       c.Names.Types,
+		Controllers: c.Names.Controllers,
+		Operations:  c.Names.Operations,
+	}
+}
@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/service"
+)
+
+var _ = Describe("Config", func() {
+	var dir string
+
+	write := func(content string) {
+		name := filepath.Join(dir, service.ConfigFile)
+		Expect(ioutil.WriteFile(name, []byte(content), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		dir, err = ioutil.TempDir("", "stride")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("loads the config successfully", func() {
+		Expect(ioutil.WriteFile(filepath.Join(dir, "swagger.yaml"), []byte("openapi: 3.0.1"), 0644)).To(Succeed())
+
+		write(`
+spec: ./swagger.yaml
+generators:
+  - golang
+  - typescript
+options:
+  golang:
+    validation: true
+layout:
+  module: example.com/bank
+  models: internal/model
+types:
+  string/uuid: github.com/google/uuid.UUID
+names:
+  types:
+    account: customer
+  operations:
+    getAccounts: listCustomers
+`)
+
+		config, err := service.LoadConfig(dir)
+		Expect(err).To(BeNil())
+		Expect(config.Spec).To(Equal(filepath.Join(dir, "swagger.yaml")))
+		Expect(config.Generators).To(Equal([]string{"golang", "typescript"}))
+
+		Expect(config.Arguments()).To(Equal([]string{
+			"golang.module=example.com/bank",
+			"markdown.module=example.com/bank",
+			"golang.models=internal/model",
+			"golang.validation=true",
+		}))
+
+		Expect(config.TypeMapping()).To(Equal(codedom.TypeMapping{
+			"string/uuid": "github.com/google/uuid.UUID",
+		}))
+
+		names := config.NameMapping()
+		Expect(names.Types).To(HaveKeyWithValue("account", "customer"))
+		Expect(names.Operations).To(HaveKeyWithValue("getAccounts", "listCustomers"))
+		Expect(names.Controllers).To(BeEmpty())
+	})
+
+	Context("when the spec is a remote source", func() {
+		It("does not change the spec", func() {
+			write("spec: git::https://github.com/phogolabs/stride//fixture/spec/operations.yaml")
+
+			config, err := service.LoadConfig(dir)
+			Expect(err).To(BeNil())
+			Expect(config.Spec).To(Equal("git::https://github.com/phogolabs/stride//fixture/spec/operations.yaml"))
+		})
+	})
+
+	Context("when the config does not exist", func() {
+		It("returns an empty config", func() {
+			config, err := service.LoadConfig(dir)
+			Expect(err).To(BeNil())
+			Expect(config.Spec).To(BeEmpty())
+			Expect(config.Generators).To(BeEmpty())
+			Expect(config.Arguments()).To(BeEmpty())
+		})
+	})
+
+	Context("when the config has an unknown key", func() {
+		It("returns an error", func() {
+			write("generator:\n  - golang\n")
+
+			config, err := service.LoadConfig(dir)
+			Expect(err).To(MatchError(ContainSubstring(`unknown field "generator"`)))
+			Expect(config).To(BeNil())
+		})
+	})
+
+	Context("when the config is not valid yaml", func() {
+		It("returns an error", func() {
+			write("generators: [golang")
+
+			config, err := service.LoadConfig(dir)
+			Expect(err).To(MatchError(ContainSubstring("invalid config")))
+			Expect(config).To(BeNil())
+		})
+	})
+})
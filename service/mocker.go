@@ -0,0 +1,232 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/inflect"
+)
+
+// MockerConfig represents the mocker config
+type MockerConfig struct {
+	Addr string
+	Seed int64
+	Spec *codedom.SpecDescriptor
+}
+
+// NewMocker creates a new mock server
+func NewMocker(config *MockerConfig) *http.Server {
+	router := chi.NewRouter()
+	router.Use(middleware.StripSlashes)
+	router.Use(middleware.RealIP)
+	router.Use(middleware.Recoverer)
+	router.Use(middleware.NoCache)
+	router.Use(middleware.Logger)
+
+	handler := &Mocker{
+		Seed: config.Seed,
+		Spec: config.Spec,
+	}
+
+	handler.Mount(router)
+
+	return &http.Server{
+		Addr:    config.Addr,
+		Handler: router,
+	}
+}
+
+// Mocker serves mock responses for the operations of a spec
+type Mocker struct {
+	Seed int64
+	Spec *codedom.SpecDescriptor
+}
+
+// Mount mounts the mocker
+func (m *Mocker) Mount(r chi.Router) {
+	for _, controller := range m.Spec.Controllers {
+		for _, operation := range controller.Operations {
+			path := operation.Path
+
+			// the router strips the trailing slashes
+			if path != "/" {
+				path = strings.TrimSuffix(path, "/")
+			}
+
+			r.Method(inflect.UpperCase(operation.Method), path, m.serve(operation))
+		}
+	}
+}
+
+func (m *Mocker) serve(operation *codedom.OperationDescriptor) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var (
+			response    = m.response(operation, r)
+			synthesizer = codedom.NewExampleSynthesizer(m.Seed)
+		)
+
+		if response == nil {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		for _, header := range response.Parameters {
+			w.Header().Set(header.Name, fmt.Sprintf("%v", synthesizer.Synthesize(header.ParameterType)))
+		}
+
+		code := response.Code
+
+		if code <= 0 {
+			code = http.StatusOK
+		}
+
+		if response.ResponseType == nil {
+			w.WriteHeader(code)
+			return
+		}
+
+		var (
+			body   = response.Example
+			buffer = &bytes.Buffer{}
+		)
+
+		if body == nil {
+			body = synthesizer.Synthesize(response.ResponseType)
+		}
+
+		if err := m.encode(buffer, response, body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", response.ContentType)
+		w.WriteHeader(code)
+		w.Write(buffer.Bytes())
+	}
+}
+
+// response returns the response of the lowest success code. The default
+// response, which usually describes the errors, is returned only if the
+// operation does not have a success response.
+func (m *Mocker) response(operation *codedom.OperationDescriptor, r *http.Request) *codedom.ResponseDescriptor {
+	var response *codedom.ResponseDescriptor
+
+	for _, descriptor := range operation.Responses {
+		if descriptor.Code < 200 || descriptor.Code > 299 {
+			continue
+		}
+
+		if response == nil || descriptor.Code < response.Code {
+			response = descriptor
+		}
+	}
+
+	if response == nil {
+		for _, descriptor := range operation.Responses {
+			if descriptor.IsDefault {
+				response = descriptor
+				break
+			}
+		}
+	}
+
+	if response == nil {
+		return nil
+	}
+
+	accept := r.Header.Get("Accept")
+
+	// negotiate the content-type among the responses with the same code
+	for _, part := range strings.Split(accept, ",") {
+		kind, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		for _, descriptor := range operation.Responses {
+			if descriptor.Code != response.Code {
+				continue
+			}
+
+			if strings.EqualFold(descriptor.ContentType, kind) {
+				return descriptor
+			}
+		}
+	}
+
+	return response
+}
+
+func (m *Mocker) encode(buffer *bytes.Buffer, response *codedom.ResponseDescriptor, body interface{}) error {
+	kind, _, err := mime.ParseMediaType(response.ContentType)
+	if err != nil {
+		kind = response.ContentType
+	}
+
+	switch {
+	case strings.HasSuffix(kind, "json"):
+		return json.NewEncoder(buffer).Encode(body)
+	case strings.HasSuffix(kind, "xml"):
+		encoder := xml.NewEncoder(buffer)
+		name := inflect.Camelize(response.ResponseType.Name)
+
+		if err := m.encodeXML(encoder, name, body); err != nil {
+			return err
+		}
+
+		return encoder.Flush()
+	default:
+		_, err := fmt.Fprintf(buffer, "%v", body)
+		return err
+	}
+}
+
+func (m *Mocker) encodeXML(encoder *xml.Encoder, name string, value interface{}) error {
+	start := xml.StartElement{
+		Name: xml.Name{Local: name},
+	}
+
+	switch item := value.(type) {
+	case map[string]interface{}:
+		if err := encoder.EncodeToken(start); err != nil {
+			return err
+		}
+
+		keys := []string{}
+
+		for key := range item {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if err := m.encodeXML(encoder, key, item[key]); err != nil {
+				return err
+			}
+		}
+
+		return encoder.EncodeToken(start.End())
+	case []interface{}:
+		for _, element := range item {
+			if err := m.encodeXML(encoder, name, element); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case nil:
+		return encoder.EncodeElement("", start)
+	default:
+		return encoder.EncodeElement(item, start)
+	}
+}
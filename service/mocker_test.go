@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/service"
+)
+
+var _ = Describe("Mocker", func() {
+	var (
+		server *http.Server
+		config *service.MockerConfig
+		path   string
+	)
+
+	BeforeEach(func() {
+		path = "../fixture/spec/operations.yaml"
+	})
+
+	JustBeforeEach(func() {
+		reporter := &fake.Reporter{}
+		reporter.WithReturns(reporter)
+
+		loader := openapi3.NewSwaggerLoader()
+
+		swagger, err := loader.LoadSwaggerFromFile(path)
+		Expect(err).To(BeNil())
+
+		resolver := &codedom.Resolver{
+			Reporter: reporter,
+			Cache:    codedom.TypeDescriptorMap{},
+		}
+
+		spec, err := resolver.Resolve(swagger)
+		Expect(err).To(BeNil())
+
+		config = &service.MockerConfig{
+			Addr: ":8080",
+			Spec: spec,
+		}
+
+		server = service.NewMocker(config)
+		go server.ListenAndServe()
+		wait(config.Addr)
+	})
+
+	AfterEach(func() {
+		Expect(server.Shutdown(context.TODO())).To(Succeed())
+	})
+
+	Context("GET /accounts", func() {
+		It("returns the mock response successfully", func() {
+			response, err := http.Get("http://127.0.0.1:8080/accounts")
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(response.Header.Get("Last-Modified")).NotTo(BeEmpty())
+
+			body := []map[string]interface{}{}
+			Expect(json.NewDecoder(response.Body).Decode(&body)).To(Succeed())
+			Expect(body).To(HaveLen(1))
+			Expect(body[0]).To(HaveKey("id"))
+			Expect(body[0]).To(HaveKey("name"))
+		})
+	})
+
+	Context("GET /account/{accountId}", func() {
+		It("returns the mock response successfully", func() {
+			response, err := http.Get("http://127.0.0.1:8080/account/4b6f3a2e-5e8b-4e0d-9c6a-0d1f1c1b2a3e")
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+			body := map[string]interface{}{}
+			Expect(json.NewDecoder(response.Body).Decode(&body)).To(Succeed())
+			Expect(body).To(HaveKey("id"))
+			Expect(body).To(HaveKey("name"))
+		})
+	})
+
+	Context("when the request is repeated", func() {
+		It("returns the same mock response", func() {
+			get := func() map[string]interface{} {
+				response, err := http.Get("http://127.0.0.1:8080/account/4b6f3a2e-5e8b-4e0d-9c6a-0d1f1c1b2a3e")
+				Expect(err).To(BeNil())
+
+				body := map[string]interface{}{}
+				Expect(json.NewDecoder(response.Body).Decode(&body)).To(Succeed())
+				return body
+			}
+
+			Expect(get()).To(Equal(get()))
+		})
+	})
+
+	Context("when the operation has a default response", func() {
+		BeforeEach(func() {
+			path = "../fixture/spec/web-api.yaml"
+		})
+
+		It("returns the success response", func() {
+			response, err := http.Get("http://127.0.0.1:8080/accounts")
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+
+			body := []map[string]interface{}{}
+			Expect(json.NewDecoder(response.Body).Decode(&body)).To(Succeed())
+			Expect(body).To(HaveLen(1))
+			Expect(body[0]).To(HaveKey("id"))
+			Expect(body[0]).NotTo(HaveKey("message"))
+		})
+	})
+
+	Context("when the operation does not exist", func() {
+		It("returns not found", func() {
+			response, err := http.Get("http://127.0.0.1:8080/users")
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(404))
+		})
+	})
+})
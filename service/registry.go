@@ -0,0 +1,212 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/phogolabs/stride/contract"
+)
+
+// GeneratorOptions are the options of a syntax generator
+type GeneratorOptions map[string]string
+
+// String returns the value of the option or the fallback if it's not set
+func (options GeneratorOptions) String(key, fallback string) string {
+	if value, ok := options[key]; ok {
+		return value
+	}
+
+	return fallback
+}
+
+// Bool returns the value of a boolean option
+func (options GeneratorOptions) Bool(key string) (bool, error) {
+	value, ok := options[key]
+	if !ok {
+		return false, nil
+	}
+
+	flag, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid option: %v=%v. The value should be a boolean", key, value)
+	}
+
+	return flag, nil
+}
+
+// GeneratorConfig is the configuration of a syntax generator
+type GeneratorConfig struct {
+	Path     string
+	Options  GeneratorOptions
+	Reporter contract.Reporter
+}
+
+// GeneratorFactory creates a syntax generator
+type GeneratorFactory func(config *GeneratorConfig) (SyntaxGenerator, error)
+
+// GeneratorOption describes an option of a syntax generator
+type GeneratorOption struct {
+	Name  string
+	Usage string
+}
+
+// GeneratorDescriptor describes a syntax generator
+type GeneratorDescriptor struct {
+	Name        string
+	Description string
+	Options     []*GeneratorOption
+	// AnyOption allows the options that are not declared. The generator
+	// validates them on its own.
+	AnyOption bool
+	Factory   GeneratorFactory
+}
+
+// HasOption returns true if the generator supports the option
+func (d *GeneratorDescriptor) HasOption(name string) bool {
+	if d.AnyOption {
+		return true
+	}
+
+	for _, option := range d.Options {
+		if option.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GeneratorSelection selects the generators that should run
+type GeneratorSelection struct {
+	// Names of the generators
+	Names []string
+	// Options in the form <generator>.<name>=<value>
+	Options []string
+	// Path to the project directory
+	Path string
+	// Reporter of the generators
+	Reporter contract.Reporter
+}
+
+// GeneratorRegistry contains the syntax generators by name
+type GeneratorRegistry struct {
+	items map[string]*GeneratorDescriptor
+}
+
+// Register registers a syntax generator
+func (r *GeneratorRegistry) Register(descriptor *GeneratorDescriptor) error {
+	if r.items == nil {
+		r.items = map[string]*GeneratorDescriptor{}
+	}
+
+	if _, ok := r.items[descriptor.Name]; ok {
+		return fmt.Errorf("generator already registered: %v", descriptor.Name)
+	}
+
+	r.items[descriptor.Name] = descriptor
+	return nil
+}
+
+// Lookup returns the syntax generator registered with the given name
+func (r *GeneratorRegistry) Lookup(name string) (*GeneratorDescriptor, bool) {
+	descriptor, ok := r.items[name]
+	return descriptor, ok
+}
+
+// Descriptors returns the registered generators sorted by name
+func (r *GeneratorRegistry) Descriptors() []*GeneratorDescriptor {
+	descriptors := []*GeneratorDescriptor{}
+
+	for _, descriptor := range r.items {
+		descriptors = append(descriptors, descriptor)
+	}
+
+	sort.Slice(descriptors, func(i, j int) bool {
+		return descriptors[i].Name < descriptors[j].Name
+	})
+
+	return descriptors
+}
+
+// Create creates a generator that runs the selected generators in order
+func (r *GeneratorRegistry) Create(selection *GeneratorSelection) (SyntaxGenerator, error) {
+	options := map[string]GeneratorOptions{}
+
+	for _, option := range selection.Options {
+		name, key, value, err := r.parse(option)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := options[name]; !ok {
+			options[name] = GeneratorOptions{}
+		}
+
+		options[name][key] = value
+	}
+
+	var (
+		generators = CompositeGenerator{}
+		selected   = map[string]bool{}
+	)
+
+	for _, name := range selection.Names {
+		descriptor, ok := r.Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown generator: %v", name)
+		}
+
+		// every generator runs once
+		if selected[name] {
+			continue
+		}
+
+		selected[name] = true
+
+		config := &GeneratorConfig{
+			Path:     selection.Path,
+			Options:  options[name],
+			Reporter: selection.Reporter,
+		}
+
+		if config.Options == nil {
+			config.Options = GeneratorOptions{}
+		}
+
+		generator, err := descriptor.Factory(config)
+		if err != nil {
+			return nil, fmt.Errorf("%v generator: %v", name, err)
+		}
+
+		generators = append(generators, generator)
+	}
+
+	return generators, nil
+}
+
+func (r *GeneratorRegistry) parse(option string) (string, string, string, error) {
+	parts := strings.SplitN(option, "=", 2)
+
+	if len(parts) != 2 {
+		return "", "", "", fmt.Errorf("invalid option: %v. The option should be in the form <generator>.<name>=<value>", option)
+	}
+
+	names := strings.SplitN(parts[0], ".", 2)
+
+	if len(names) != 2 || names[0] == "" || names[1] == "" {
+		return "", "", "", fmt.Errorf("invalid option: %v. The option should be in the form <generator>.<name>=<value>", option)
+	}
+
+	descriptor, ok := r.Lookup(names[0])
+	if !ok {
+		return "", "", "", fmt.Errorf("unknown generator: %v", names[0])
+	}
+
+	if !descriptor.HasOption(names[1]) {
+		return "", "", "", fmt.Errorf("unknown option: %v", parts[0])
+	}
+
+	return names[0], names[1], parts[1], nil
+}
@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/service"
+)
+
+var _ = Describe("GeneratorRegistry", func() {
+	var (
+		registry *service.GeneratorRegistry
+		configs  []*service.GeneratorConfig
+		reporter *fake.Reporter
+	)
+
+	factory := func(config *service.GeneratorConfig) (service.SyntaxGenerator, error) {
+		configs = append(configs, config)
+
+		if _, err := config.Options.Bool("verbose"); err != nil {
+			return nil, err
+		}
+
+		return &fake.SyntaxGenerator{}, nil
+	}
+
+	BeforeEach(func() {
+		configs = nil
+		reporter = &fake.Reporter{}
+		registry = &service.GeneratorRegistry{}
+
+		Expect(registry.Register(&service.GeneratorDescriptor{
+			Name:    "golang",
+			Factory: factory,
+			Options: []*service.GeneratorOption{
+				{Name: "package", Usage: "name of the package"},
+				{Name: "verbose", Usage: "verbose output"},
+			},
+		})).To(Succeed())
+
+		Expect(registry.Register(&service.GeneratorDescriptor{
+			Name:    "csharp",
+			Factory: factory,
+		})).To(Succeed())
+	})
+
+	It("returns the descriptors sorted by name", func() {
+		descriptors := registry.Descriptors()
+		Expect(descriptors).To(HaveLen(2))
+		Expect(descriptors[0].Name).To(Equal("csharp"))
+		Expect(descriptors[1].Name).To(Equal("golang"))
+	})
+
+	It("looks up a generator", func() {
+		descriptor, ok := registry.Lookup("golang")
+		Expect(ok).To(BeTrue())
+		Expect(descriptor.HasOption("package")).To(BeTrue())
+		Expect(descriptor.HasOption("namespace")).To(BeFalse())
+
+		_, ok = registry.Lookup("rust")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("creates the selected generators", func() {
+		generator, err := registry.Create(&service.GeneratorSelection{
+			Names:    []string{"csharp", "golang", "csharp"},
+			Options:  []string{"golang.package=api", "golang.verbose=true"},
+			Path:     "/tmp/project",
+			Reporter: reporter,
+		})
+
+		Expect(err).To(BeNil())
+		Expect(generator).To(HaveLen(2))
+		Expect(configs).To(HaveLen(2))
+
+		Expect(configs[0].Path).To(Equal("/tmp/project"))
+		Expect(configs[0].Reporter).To(Equal(reporter))
+		Expect(configs[0].Options).To(BeEmpty())
+
+		Expect(configs[1].Options).To(HaveKeyWithValue("package", "api"))
+		Expect(configs[1].Options.String("package", "service")).To(Equal("api"))
+		Expect(configs[1].Options.String("module", "none")).To(Equal("none"))
+		Expect(configs[1].Options.Bool("verbose")).To(BeTrue())
+	})
+
+	Context("when the generator accepts any option", func() {
+		BeforeEach(func() {
+			descriptor, _ := registry.Lookup("csharp")
+			descriptor.AnyOption = true
+		})
+
+		It("passes the options through", func() {
+			_, err := registry.Create(&service.GeneratorSelection{
+				Names:   []string{"csharp"},
+				Options: []string{"csharp.namespace=Bank"},
+			})
+
+			Expect(err).To(BeNil())
+			Expect(configs).To(HaveLen(1))
+			Expect(configs[0].Options).To(HaveKeyWithValue("namespace", "Bank"))
+		})
+	})
+
+	Context("when the generator is already registered", func() {
+		It("returns an error", func() {
+			err := registry.Register(&service.GeneratorDescriptor{Name: "golang"})
+			Expect(err).To(MatchError("generator already registered: golang"))
+		})
+	})
+
+	Context("when the generator is unknown", func() {
+		It("returns an error", func() {
+			_, err := registry.Create(&service.GeneratorSelection{
+				Names: []string{"rust"},
+			})
+
+			Expect(err).To(MatchError("unknown generator: rust"))
+		})
+	})
+
+	DescribeTable("when the option is invalid",
+		func(option string, msg string) {
+			_, err := registry.Create(&service.GeneratorSelection{
+				Names:   []string{"golang"},
+				Options: []string{option},
+			})
+
+			Expect(err).To(MatchError(msg))
+		},
+		Entry("without value", "golang.package", "invalid option: golang.package. The option should be in the form <generator>.<name>=<value>"),
+		Entry("without generator", "package=api", "invalid option: package=api. The option should be in the form <generator>.<name>=<value>"),
+		Entry("of unknown generator", "rust.package=api", "unknown generator: rust"),
+		Entry("unknown", "golang.module=api", "unknown option: golang.module"),
+		Entry("with invalid value", "golang.verbose=maybe", "golang generator: invalid option: verbose=maybe. The value should be a boolean"),
+	)
+
+	Context("when the factory fails", func() {
+		BeforeEach(func() {
+			registry.Register(&service.GeneratorDescriptor{
+				Name: "rust",
+				Factory: func(config *service.GeneratorConfig) (service.SyntaxGenerator, error) {
+					return nil, fmt.Errorf("oh no")
+				},
+			})
+		})
+
+		It("returns an error", func() {
+			_, err := registry.Create(&service.GeneratorSelection{
+				Names: []string{"rust"},
+			})
+
+			Expect(err).To(MatchError("rust generator: oh no"))
+		})
+	})
+})
@@ -0,0 +1,264 @@
+package csharp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/inflect"
+)
+
+var keywords = map[string]bool{
+	"abstract": true, "as": true, "base": true, "bool": true, "break": true,
+	"byte": true, "case": true, "catch": true, "char": true, "checked": true,
+	"class": true, "const": true, "continue": true, "decimal": true, "default": true,
+	"delegate": true, "do": true, "double": true, "else": true, "enum": true,
+	"event": true, "explicit": true, "extern": true, "false": true, "finally": true,
+	"fixed": true, "float": true, "for": true, "foreach": true, "goto": true,
+	"if": true, "implicit": true, "in": true, "int": true, "interface": true,
+	"internal": true, "is": true, "lock": true, "long": true, "namespace": true,
+	"new": true, "null": true, "object": true, "operator": true, "out": true,
+	"override": true, "params": true, "private": true, "protected": true, "public": true,
+	"readonly": true, "ref": true, "return": true, "sbyte": true, "sealed": true,
+	"short": true, "sizeof": true, "stackalloc": true, "static": true, "string": true,
+	"struct": true, "switch": true, "this": true, "throw": true, "true": true,
+	"try": true, "typeof": true, "uint": true, "ulong": true, "unchecked": true,
+	"unsafe": true, "ushort": true, "using": true, "virtual": true, "void": true,
+	"volatile": true, "while": true,
+}
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// File represents a c# source file
+type File struct {
+	name   string
+	buffer *bytes.Buffer
+}
+
+// NewFile creates a new file
+func NewFile(name string) *File {
+	return &File{
+		name:   name,
+		buffer: &bytes.Buffer{},
+	}
+}
+
+// Name returns the file name
+func (f *File) Name() string {
+	return f.name
+}
+
+// Printf writes a formatted line
+func (f *File) Printf(format string, args ...interface{}) {
+	fmt.Fprintf(f.buffer, format, args...)
+	fmt.Fprintln(f.buffer)
+}
+
+// Commentf writes a documentation comment with the given indentation
+func (f *File) Commentf(indent, text string) {
+	text = strings.TrimSpace(text)
+
+	if text == "" {
+		return
+	}
+
+	f.Printf("%s/// <summary>", indent)
+
+	for _, line := range strings.Split(escaper.Replace(text), "\n") {
+		f.Printf("%s", strings.TrimRight(indent+"/// "+strings.TrimSpace(line), " "))
+	}
+
+	f.Printf("%s/// </summary>", indent)
+}
+
+// Write writes the content to the file
+func (f *File) Write(data []byte) (int, error) {
+	return f.buffer.Write(data)
+}
+
+// WriteTo writes the content to the writer
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	return bytes.NewReader(f.buffer.Bytes()).WriteTo(w)
+}
+
+// String returns the content
+func (f *File) String() string {
+	return f.buffer.String()
+}
+
+// Sync writes the content to the disk
+func (f *File) Sync() error {
+	if err := os.MkdirAll(filepath.Dir(f.name), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(f.name)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	_, err = f.WriteTo(file)
+	return err
+}
+
+// kind returns the c# type of the descriptor
+func kind(descriptor *codedom.TypeDescriptor) string {
+	var name string
+
+	switch {
+	case descriptor.IsAlias:
+		return kind(descriptor.Element)
+	case descriptor.IsAny:
+		name = "object"
+	case descriptor.IsMap:
+		name = fmt.Sprintf("Dictionary<string, %s>", kind(descriptor.Element))
+	case descriptor.IsArray:
+		name = fmt.Sprintf("List<%s>", kind(descriptor.Element))
+	case descriptor.IsEnum && !declared(descriptor):
+		name = kind(descriptor.Element)
+	case descriptor.IsPrimitive:
+		switch descriptor.Name {
+		case "int32":
+			name = "int"
+		case "int64":
+			name = "long"
+		case "float32":
+			name = "float"
+		case "float64":
+			name = "double"
+		case "boolean":
+			name = "bool"
+		case "uuid":
+			name = "Guid"
+		case "date":
+			name = "DateOnly"
+		case "date-time":
+			name = "DateTimeOffset"
+		case "byte", "binary":
+			name = "byte[]"
+		default:
+			name = "string"
+		}
+	default:
+		name = inflect.Camelize(descriptor.Name)
+	}
+
+	// the classes and unions are nullable, because golang refers to them by pointer
+	if descriptor.IsNullable && !descriptor.IsClass && !descriptor.IsUnion {
+		name = optional(name)
+	}
+
+	return name
+}
+
+// declared returns true if the descriptor is declared as a c# type
+func declared(descriptor *codedom.TypeDescriptor) bool {
+	switch {
+	case descriptor.IsClass, descriptor.IsUnion:
+		return true
+	case descriptor.IsEnum:
+		// the enums of floating point numbers are validated by the allowed values
+		if element := descriptor.Element; element != nil {
+			return element.Name != "float32" && element.Name != "float64"
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+// optional returns the nullable form of the type
+func optional(name string) string {
+	if strings.HasSuffix(name, "?") {
+		return name
+	}
+
+	return name + "?"
+}
+
+// element returns the type that an alias refers to
+func element(descriptor *codedom.TypeDescriptor) *codedom.TypeDescriptor {
+	for descriptor.IsAlias && descriptor.Element != nil {
+		descriptor = descriptor.Element
+	}
+
+	return descriptor
+}
+
+// variable returns the name of a parameter or a local variable
+func variable(name string) string {
+	var (
+		runes = []rune(inflect.Camelize(name))
+		count = 0
+	)
+
+	// the leading acronym is lower cased as a whole (ID, URLPath)
+	for count < len(runes) && unicode.IsUpper(runes[count]) {
+		count++
+	}
+
+	// the last upper case letter starts the next word
+	if count > 1 && count < len(runes) {
+		count--
+	}
+
+	for index := 0; index < count; index++ {
+		runes[index] = unicode.ToLower(runes[index])
+	}
+
+	name = string(runes)
+
+	if keywords[name] {
+		name = "@" + name
+	}
+
+	return name
+}
+
+// member returns the name of an enum member for the given value
+func member(value interface{}) string {
+	text := literal(value)
+
+	if item, ok := value.(string); ok {
+		text = item
+	} else {
+		text = "value-" + strings.NewReplacer("-", "minus-", ".", "-dot-").Replace(text)
+	}
+
+	name := inflect.Camelize(text)
+
+	if char, _ := utf8.DecodeRuneInString(name); !unicode.IsLetter(char) {
+		name = "Value" + name
+	}
+
+	return name
+}
+
+// literal returns the c# literal of the value
+func literal(value interface{}) string {
+	switch item := value.(type) {
+	case string:
+		return strconv.Quote(item)
+	case float32:
+		return strconv.FormatFloat(float64(item), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(item, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", item)
+	}
+}
+
+// verbatim returns the c# verbatim string literal of the text
+func verbatim(text string) string {
+	return `@"` + strings.Replace(text, `"`, `""`, -1) + `"`
+}
@@ -0,0 +1,132 @@
+package csharp
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/inflect"
+)
+
+// FileGenerator is a file generator
+type FileGenerator interface {
+	// Generate generates the file
+	Generate() *File
+}
+
+// Generator generates an ASP.NET Core Web API
+type Generator struct {
+	Path      string
+	Namespace string
+	Reporter  contract.Reporter
+}
+
+// Generate generates the source code
+func (g *Generator) Generate(spec *codedom.SpecDescriptor) error {
+	reporter := g.Reporter.With(contract.SeverityVeryHigh)
+	reporter.Notice(" Generating web api...")
+
+	namespace := g.Namespace
+
+	if namespace == "" {
+		namespace = inflect.Camelize(filepath.Base(g.Path))
+	}
+
+	// the generated files are overwritten
+	generators := []FileGenerator{
+		&ProjectGenerator{
+			Path:      g.Path,
+			Namespace: namespace,
+			Mode:      ProjectGeneratorModeValidation,
+			Reporter:  g.Reporter,
+		},
+	}
+
+	for _, descriptor := range spec.Types {
+		generators = append(generators, &SchemaGenerator{
+			Path:       filepath.Join(g.Path, "Models"),
+			Namespace:  namespace,
+			Descriptor: descriptor,
+			Reporter:   g.Reporter,
+		})
+	}
+
+	for _, descriptor := range spec.Controllers {
+		generators = append(generators, &ControllerGenerator{
+			Path:       filepath.Join(g.Path, "Controllers"),
+			Namespace:  namespace,
+			Mode:       ControllerGeneratorModeBase,
+			Controller: descriptor,
+			Reporter:   g.Reporter,
+		})
+	}
+
+	// the scaffolded files are owned by the user
+	scaffolds := []FileGenerator{
+		&ProjectGenerator{
+			Path:      g.Path,
+			Namespace: namespace,
+			Mode:      ProjectGeneratorModeProject,
+			Reporter:  g.Reporter,
+		},
+		&ProjectGenerator{
+			Path:      g.Path,
+			Namespace: namespace,
+			Mode:      ProjectGeneratorModeProgram,
+			Reporter:  g.Reporter,
+		},
+	}
+
+	for _, descriptor := range spec.Controllers {
+		scaffolds = append(scaffolds, &ControllerGenerator{
+			Path:       filepath.Join(g.Path, "Controllers"),
+			Namespace:  namespace,
+			Mode:       ControllerGeneratorModeAPI,
+			Controller: descriptor,
+			Reporter:   g.Reporter,
+		})
+	}
+
+	for _, generator := range generators {
+		if err := g.sync(generator, true); err != nil {
+			reporter.Error(" Generating web api fail")
+			return err
+		}
+	}
+
+	for _, generator := range scaffolds {
+		if err := g.sync(generator, false); err != nil {
+			reporter.Error(" Generating web api fail")
+			return err
+		}
+	}
+
+	reporter.Success(" Generating web api complete!")
+	return nil
+}
+
+func (g *Generator) sync(generator FileGenerator, overwrite bool) error {
+	reporter := g.Reporter.With(contract.SeverityLow)
+
+	target := generator.Generate()
+
+	if target == nil {
+		return nil
+	}
+
+	if _, err := os.Stat(target.Name()); err == nil && !overwrite {
+		reporter.Info(" Skipping file: %s. It already exists", target.Name())
+		return nil
+	}
+
+	reporter.Info(" Sync file: %s...", target.Name())
+
+	if err := target.Sync(); err != nil {
+		reporter.Error(" Sync file: %s fail: %v", target.Name(), err)
+		return err
+	}
+
+	reporter.Success(" Sync file: %s successful", target.Name())
+	return nil
+}
@@ -0,0 +1,325 @@
+package csharp
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/inflect"
+)
+
+// ControllerGeneratorMode determines the mode of this generator
+type ControllerGeneratorMode byte
+
+const (
+	// ControllerGeneratorModeBase generates the abstract controller that declares the routes
+	ControllerGeneratorModeBase ControllerGeneratorMode = 0
+	// ControllerGeneratorModeAPI generates the controller that implements the operations
+	ControllerGeneratorModeAPI ControllerGeneratorMode = 1
+)
+
+// the binding attributes of the parameter locations
+var bindings = map[string]string{
+	"path":   "FromRoute",
+	"query":  "FromQuery",
+	"header": "FromHeader",
+}
+
+// ControllerGenerator builds a controller
+type ControllerGenerator struct {
+	Path       string
+	Namespace  string
+	Mode       ControllerGeneratorMode
+	Controller *codedom.ControllerDescriptor
+	Reporter   contract.Reporter
+}
+
+// Generate generates a file
+func (g *ControllerGenerator) Generate() *File {
+	switch g.Mode {
+	case ControllerGeneratorModeBase:
+		return g.base()
+	case ControllerGeneratorModeAPI:
+		return g.api()
+	default:
+		return nil
+	}
+}
+
+func (g *ControllerGenerator) base() *File {
+	var (
+		name = inflect.Camelize(g.Controller.Name) + "ControllerBase"
+		root = NewFile(filepath.Join(g.Path, name+".cs"))
+	)
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+	reporter.Notice(" Generating controller: %s file: %s...", inflect.Dasherize(name), root.Name())
+
+	root.Printf("using System.ComponentModel.DataAnnotations;")
+	root.Printf("using Microsoft.AspNetCore.Mvc;")
+	root.Printf("using %s.Models;", g.Namespace)
+	root.Printf("")
+	root.Printf("namespace %s.Controllers;", g.Namespace)
+	root.Printf("")
+	root.Commentf("", g.Controller.Description)
+	root.Printf("[ApiController]")
+	root.Printf("public abstract class %s : ControllerBase", name)
+	root.Printf("{")
+
+	for index, operation := range g.Controller.Operations {
+		if index > 0 {
+			root.Printf("")
+		}
+
+		g.operation(root, operation)
+	}
+
+	root.Printf("}")
+
+	reporter.Notice(" Generating controller: %s file: %s successful", inflect.Dasherize(name), root.Name())
+	return root
+}
+
+func (g *ControllerGenerator) api() *File {
+	var (
+		name = inflect.Camelize(g.Controller.Name) + "Controller"
+		root = NewFile(filepath.Join(g.Path, name+".cs"))
+	)
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+	reporter.Notice(" Generating controller: %s file: %s...", inflect.Dasherize(name), root.Name())
+
+	root.Printf("using Microsoft.AspNetCore.Mvc;")
+	root.Printf("using %s.Models;", g.Namespace)
+	root.Printf("")
+	root.Printf("namespace %s.Controllers;", g.Namespace)
+	root.Printf("")
+	root.Printf("public class %s : %sBase", name, name)
+	root.Printf("{")
+
+	for index, operation := range g.Controller.Operations {
+		if index > 0 {
+			root.Printf("")
+		}
+
+		arguments := []string{}
+
+		for _, argument := range g.arguments(operation) {
+			arguments = append(arguments, argument.Kind+" "+argument.Name)
+		}
+
+		// the overrides of the obsolete members must be obsolete as well
+		if operation.Deprecated {
+			root.Printf("    [Obsolete(\"The operation is obsolete\")]")
+		}
+
+		root.Printf("    public override Task<IActionResult> %s(%s)", inflect.Camelize(operation.Name), strings.Join(arguments, ", "))
+		root.Printf("    {")
+		root.Printf("        throw new NotImplementedException();")
+		root.Printf("    }")
+	}
+
+	root.Printf("}")
+
+	reporter.Notice(" Generating controller: %s file: %s successful", inflect.Dasherize(name), root.Name())
+	return root
+}
+
+func (g *ControllerGenerator) operation(root *File, operation *codedom.OperationDescriptor) {
+	var (
+		name    = inflect.Camelize(operation.Name)
+		request = g.request(operation)
+		accept  = []string{}
+	)
+
+	g.Reporter.Info("ﳑ Generating controller operation: %s...", inflect.Dasherize(operation.Name))
+
+	if len(operation.Requests) > 1 {
+		reporter := g.Reporter.With(contract.SeverityLow)
+		reporter.Warn("ﳑ Generating controller operation: %s uses request content-type: %s. More than one request per operation is not supported",
+			inflect.Dasherize(operation.Name),
+			inflect.Dasherize(request.ContentType),
+		)
+	}
+
+	for _, response := range operation.Responses {
+		if kind := response.ContentType; response.ResponseType != nil && !g.contains(accept, kind) {
+			accept = append(accept, fmt.Sprintf("%q", kind))
+		}
+	}
+
+	comment := strings.TrimSpace(strings.Join([]string{
+		strings.TrimSpace(operation.Summary),
+		strings.TrimSpace(operation.Description),
+	}, "\n"))
+
+	root.Commentf("    ", comment)
+
+	if operation.Deprecated {
+		root.Printf("    [Obsolete(\"The operation is obsolete\")]")
+	}
+
+	switch method := strings.ToUpper(operation.Method); method {
+	case "GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS":
+		root.Printf("    [Http%s(%q)]", inflect.Camelize(strings.ToLower(method)), operation.Path)
+	default:
+		root.Printf("    [AcceptVerbs(%q, Route = %q)]", method, operation.Path)
+	}
+
+	if request.RequestType != nil {
+		root.Printf("    [Consumes(%q)]", request.ContentType)
+	}
+
+	if len(accept) > 0 {
+		root.Printf("    [Produces(%s)]", strings.Join(accept, ", "))
+	}
+
+	for _, response := range g.responses(operation) {
+		switch {
+		case response.Code < 0 && response.ResponseType != nil:
+			root.Printf("    [ProducesDefaultResponseType(typeof(%s))]", kind(response.ResponseType))
+		case response.Code < 0:
+			root.Printf("    [ProducesDefaultResponseType]")
+		case response.ResponseType != nil:
+			root.Printf("    [ProducesResponseType(typeof(%s), %d)]", kind(response.ResponseType), response.Code)
+		default:
+			root.Printf("    [ProducesResponseType(%d)]", response.Code)
+		}
+	}
+
+	arguments := []string{}
+
+	for _, argument := range g.arguments(operation) {
+		arguments = append(arguments, fmt.Sprintf("        %s %s %s", strings.Join(argument.Attributes, " "), argument.Kind, argument.Name))
+	}
+
+	if len(arguments) == 0 {
+		root.Printf("    public abstract Task<IActionResult> %s();", name)
+	} else {
+		root.Printf("    public abstract Task<IActionResult> %s(", name)
+		root.Printf("%s);", strings.Join(arguments, ",\n"))
+	}
+
+	g.Reporter.Success("ﳑ Generating controller operation: %s successful", inflect.Dasherize(operation.Name))
+}
+
+type argument struct {
+	Name       string
+	Kind       string
+	Attributes []string
+}
+
+func (g *ControllerGenerator) arguments(operation *codedom.OperationDescriptor) []*argument {
+	var (
+		request   = g.request(operation)
+		arguments = []*argument{}
+	)
+
+	for _, parameter := range request.Parameters {
+		binding, ok := bindings[strings.ToLower(parameter.In)]
+
+		// the base controller reports the unsupported parameters
+		if !ok && g.Mode == ControllerGeneratorModeBase {
+			reporter := g.Reporter.With(contract.SeverityLow)
+			reporter.Warn("ﳑ Generating controller operation: %s parameter: %s. The %s parameters are not supported",
+				inflect.Dasherize(operation.Name),
+				inflect.Dasherize(parameter.Name),
+				strings.ToLower(parameter.In),
+			)
+		}
+
+		if !ok {
+			continue
+		}
+
+		item := &argument{
+			Name: variable(parameter.Name),
+			Kind: kind(parameter.ParameterType),
+			Attributes: []string{
+				fmt.Sprintf("[%s(Name = %q)]", binding, parameter.Name),
+			},
+		}
+
+		if !parameter.Required {
+			item.Kind = optional(item.Kind)
+		}
+
+		for _, annotation := range annotations(parameter.ParameterType, parameter.Required) {
+			item.Attributes = append(item.Attributes, fmt.Sprintf("[%s]", annotation))
+		}
+
+		arguments = append(arguments, item)
+	}
+
+	if request.RequestType != nil {
+		item := &argument{
+			Name:       "body",
+			Kind:       kind(request.RequestType),
+			Attributes: []string{"[FromBody]"},
+		}
+
+		if request.Required {
+			item.Attributes = append(item.Attributes, "[Required]")
+		} else {
+			item.Kind = optional(item.Kind)
+		}
+
+		arguments = append(arguments, item)
+	}
+
+	return arguments
+}
+
+func (g *ControllerGenerator) responses(operation *codedom.OperationDescriptor) codedom.ResponseDescriptorCollection {
+	var (
+		codes     = map[int]bool{}
+		responses = codedom.ResponseDescriptorCollection{}
+	)
+
+	for _, response := range operation.Responses {
+		// the responses with the same code share the type
+		if codes[response.Code] {
+			continue
+		}
+
+		codes[response.Code] = true
+		responses = append(responses, response)
+	}
+
+	// the default response is the last one
+	sort.SliceStable(responses, func(i, j int) bool {
+		var (
+			left  = responses[i].Code
+			right = responses[j].Code
+		)
+
+		if left < 0 || right < 0 {
+			return right < 0 && left >= 0
+		}
+
+		return left < right
+	})
+
+	return responses
+}
+
+func (g *ControllerGenerator) request(operation *codedom.OperationDescriptor) *codedom.RequestDescriptor {
+	if len(operation.Requests) > 0 {
+		return operation.Requests[0]
+	}
+
+	return &codedom.RequestDescriptor{}
+}
+
+func (g *ControllerGenerator) contains(items []string, item string) bool {
+	for _, value := range items {
+		if strings.EqualFold(value, fmt.Sprintf("%q", item)) {
+			return true
+		}
+	}
+
+	return false
+}
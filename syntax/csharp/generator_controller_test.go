@@ -0,0 +1,108 @@
+package csharp_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/syntax/csharp"
+)
+
+var _ = Describe("ControllerGenerator", func() {
+	var (
+		generator *csharp.ControllerGenerator
+		reporter  *fake.Reporter
+	)
+
+	BeforeEach(func() {
+		reporter = &fake.Reporter{}
+		reporter.WithReturns(reporter)
+
+		generator = &csharp.ControllerGenerator{
+			Path:      "/tmp/Controllers",
+			Namespace: "App",
+			Reporter:  reporter,
+			Controller: &codedom.ControllerDescriptor{
+				Name: "user",
+				Operations: codedom.OperationDescriptorCollection{
+					&codedom.OperationDescriptor{
+						Name:   "get-user",
+						Method: "PURGE",
+						Path:   "/users/{id}",
+						Requests: codedom.RequestDescriptorCollection{
+							&codedom.RequestDescriptor{
+								Parameters: codedom.ParameterDescriptorCollection{
+									&codedom.ParameterDescriptor{
+										Name:     "id",
+										In:       "path",
+										Required: true,
+										ParameterType: &codedom.TypeDescriptor{
+											Name:        "int64",
+											IsPrimitive: true,
+										},
+									},
+									&codedom.ParameterDescriptor{
+										Name: "session",
+										In:   "cookie",
+										ParameterType: &codedom.TypeDescriptor{
+											Name:        "string",
+											IsPrimitive: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("generates the base controller", func() {
+		file := generator.Generate()
+		Expect(file.Name()).To(Equal("/tmp/Controllers/UserControllerBase.cs"))
+
+		content := file.String()
+		Expect(content).To(ContainSubstring("public abstract class UserControllerBase : ControllerBase"))
+		Expect(content).To(ContainSubstring("[AcceptVerbs(\"PURGE\", Route = \"/users/{id}\")]"))
+		Expect(content).To(ContainSubstring("[FromRoute(Name = \"id\")] [Required] long id);"))
+		Expect(content).NotTo(ContainSubstring("session"))
+	})
+
+	It("reports the cookie parameters", func() {
+		generator.Generate()
+		Expect(reporter.WarnCallCount()).To(Equal(1))
+
+		_, args := reporter.WarnArgsForCall(0)
+		Expect(args).To(ContainElement("session"))
+	})
+
+	Context("when the mode is api", func() {
+		BeforeEach(func() {
+			generator.Mode = csharp.ControllerGeneratorModeAPI
+		})
+
+		It("generates the controller", func() {
+			file := generator.Generate()
+			Expect(file.Name()).To(Equal("/tmp/Controllers/UserController.cs"))
+
+			content := file.String()
+			Expect(content).To(ContainSubstring("public class UserController : UserControllerBase"))
+			Expect(content).To(ContainSubstring("public override Task<IActionResult> GetUser(long id)"))
+			Expect(content).To(ContainSubstring("throw new NotImplementedException();"))
+			Expect(reporter.WarnCallCount()).To(BeZero())
+		})
+
+		Context("when the operation is deprecated", func() {
+			BeforeEach(func() {
+				generator.Controller.Operations[0].Deprecated = true
+			})
+
+			It("marks the override as obsolete", func() {
+				content := generator.Generate().String()
+				Expect(content).To(ContainSubstring("[Obsolete(\"The operation is obsolete\")]\n    public override Task<IActionResult> GetUser(long id)"))
+			})
+		})
+	})
+})
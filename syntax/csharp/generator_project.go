@@ -0,0 +1,64 @@
+package csharp
+
+import (
+	"path/filepath"
+
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/syntax"
+)
+
+// ProjectGeneratorMode determines the mode of this generator
+type ProjectGeneratorMode byte
+
+const (
+	// ProjectGeneratorModeProject generates the project file
+	ProjectGeneratorModeProject ProjectGeneratorMode = 0
+	// ProjectGeneratorModeProgram generates the entry point of the application
+	ProjectGeneratorModeProgram ProjectGeneratorMode = 1
+	// ProjectGeneratorModeValidation generates the custom validation attributes
+	ProjectGeneratorModeValidation ProjectGeneratorMode = 2
+)
+
+// ProjectGenerator builds the files shared by the controllers and the models
+type ProjectGenerator struct {
+	Path      string
+	Namespace string
+	Mode      ProjectGeneratorMode
+	Reporter  contract.Reporter
+}
+
+// Generate generates a file
+func (g *ProjectGenerator) Generate() *File {
+	switch g.Mode {
+	case ProjectGeneratorModeProject:
+		return g.render(filepath.Join(g.Path, g.Namespace+".csproj"), "project.csproj")
+	case ProjectGeneratorModeProgram:
+		return g.render(filepath.Join(g.Path, "Program.cs"), "program.cs")
+	case ProjectGeneratorModeValidation:
+		return g.render(filepath.Join(g.Path, "Models", "Validation.cs"), "validation.cs")
+	default:
+		return nil
+	}
+}
+
+func (g *ProjectGenerator) render(filename, template string) *File {
+	root := NewFile(filename)
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+	reporter.Notice(" Generating file: %s...", root.Name())
+
+	writer := &syntax.TemplateWriter{
+		Path: "syntax/csharp/" + template + ".tpl",
+		Context: map[string]interface{}{
+			"namespace": g.Namespace,
+		},
+	}
+
+	if _, err := writer.WriteTo(root); err != nil {
+		reporter.Error(" Generating file: %s fail: %v", root.Name(), err)
+		return nil
+	}
+
+	reporter.Notice(" Generating file: %s successful", root.Name())
+	return root
+}
@@ -0,0 +1,388 @@
+package csharp
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/inflect"
+)
+
+// SchemaGenerator builds the model of a type
+type SchemaGenerator struct {
+	Path       string
+	Namespace  string
+	Descriptor *codedom.TypeDescriptor
+	Reporter   contract.Reporter
+}
+
+// Generate generates a file
+func (g *SchemaGenerator) Generate() *File {
+	descriptor := g.Descriptor
+
+	// the arrays, maps, aliases and numeric enums are not declared
+	if !declared(descriptor) {
+		return nil
+	}
+
+	var (
+		name = inflect.Camelize(descriptor.Name)
+		root = NewFile(filepath.Join(g.Path, name+".cs"))
+	)
+
+	g.Reporter.Info("ﳑ Generating type: %s...", inflect.Dasherize(descriptor.Name))
+
+	root.Printf("using System.ComponentModel.DataAnnotations;")
+	root.Printf("using System.Text.Json;")
+	root.Printf("using System.Text.Json.Serialization;")
+	root.Printf("")
+	root.Printf("namespace %s.Models;", g.Namespace)
+	root.Printf("")
+
+	switch {
+	case descriptor.IsEnum:
+		g.enum(root, descriptor)
+	case descriptor.IsUnion:
+		g.union(root, descriptor)
+	case descriptor.IsClass:
+		g.class(root, descriptor)
+	}
+
+	g.Reporter.Success("ﳑ Generating type: %s successful", inflect.Dasherize(descriptor.Name))
+	return root
+}
+
+func (g *SchemaGenerator) enum(root *File, descriptor *codedom.TypeDescriptor) {
+	var (
+		name   = inflect.Camelize(descriptor.Name)
+		text   = true
+		values = []interface{}{}
+	)
+
+	if items, ok := descriptor.Metadata["values"].([]interface{}); ok {
+		for _, item := range items {
+			if item != nil {
+				values = append(values, item)
+			}
+		}
+	}
+
+	if element := descriptor.Element; element != nil {
+		text = element.Name == "string"
+	}
+
+	root.Commentf("", descriptor.Description)
+
+	switch {
+	case text:
+		root.Printf("[JsonConverter(typeof(JsonStringEnumConverter<%s>))]", name)
+		root.Printf("public enum %s", name)
+	case descriptor.Element.Name == "int64":
+		root.Printf("public enum %s : long", name)
+	default:
+		root.Printf("public enum %s", name)
+	}
+
+	root.Printf("{")
+
+	for _, value := range values {
+		if text {
+			root.Printf("    [JsonStringEnumMemberName(%s)]", literal(value))
+			root.Printf("    %s,", member(value))
+		} else {
+			root.Printf("    %s = %s,", member(value), literal(value))
+		}
+	}
+
+	root.Printf("}")
+}
+
+func (g *SchemaGenerator) union(root *File, descriptor *codedom.TypeDescriptor) {
+	var (
+		name          = inflect.Camelize(descriptor.Name)
+		discriminator = ""
+	)
+
+	if value, ok := descriptor.Metadata["discriminator"].(string); ok {
+		discriminator = value
+	}
+
+	root.Commentf("", descriptor.Description)
+	root.Printf("[JsonConverter(typeof(%sJsonConverter))]", name)
+	root.Printf("public sealed class %s", name)
+	root.Printf("{")
+	root.Printf("    public %s(object value)", name)
+	root.Printf("    {")
+	root.Printf("        Value = value;")
+	root.Printf("    }")
+	root.Printf("")
+	root.Printf("    /// <summary>")
+	root.Printf("    /// The value is one of the variants of %s", name)
+	root.Printf("    /// </summary>")
+	root.Printf("    public object Value { get; }")
+	root.Printf("}")
+	root.Printf("")
+	root.Printf("/// <summary>")
+	root.Printf("/// Converts the variants of %s by the discriminator", name)
+	root.Printf("/// </summary>")
+	root.Printf("public sealed class %sJsonConverter : JsonConverter<%s>", name, name)
+	root.Printf("{")
+	root.Printf("    public override %s? Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options)", name)
+	root.Printf("    {")
+	root.Printf("        using var document = JsonDocument.ParseValue(ref reader);")
+
+	if discriminator == "" {
+		// the variant cannot be determined without a discriminator
+		root.Printf("        return new %s(document.RootElement.Clone());", name)
+	} else {
+		root.Printf("")
+		root.Printf("        if (!document.RootElement.TryGetProperty(%q, out var discriminator))", discriminator)
+		root.Printf("        {")
+		root.Printf("            throw new JsonException(%q);", fmt.Sprintf("the %s property is required", discriminator))
+		root.Printf("        }")
+		root.Printf("")
+		root.Printf("        switch (discriminator.GetString())")
+		root.Printf("        {")
+
+		for _, property := range descriptor.Properties {
+			root.Printf("            case %q:", property.Name)
+			root.Printf("                return new %s(document.RootElement.Deserialize<%s>(options)!);", name, kind(property.PropertyType))
+		}
+
+		root.Printf("            default:")
+		root.Printf("                throw new JsonException($\"unknown %s: {discriminator.GetString()}\");", discriminator)
+		root.Printf("        }")
+	}
+
+	root.Printf("    }")
+	root.Printf("")
+	root.Printf("    public override void Write(Utf8JsonWriter writer, %s value, JsonSerializerOptions options)", name)
+	root.Printf("    {")
+	root.Printf("        JsonSerializer.Serialize(writer, value.Value, value.Value.GetType(), options);")
+	root.Printf("    }")
+	root.Printf("}")
+}
+
+func (g *SchemaGenerator) class(root *File, descriptor *codedom.TypeDescriptor) {
+	var (
+		name       = inflect.Camelize(descriptor.Name)
+		base       = ""
+		properties = codedom.PropertyDescriptorCollection{}
+		extension  = false
+	)
+
+	for _, property := range descriptor.Properties {
+		switch {
+		case !property.IsEmbedded:
+			properties = append(properties, property)
+		case property.PropertyType.IsMap:
+			extension = true
+		case base == "":
+			base = inflect.Camelize(property.PropertyType.Name)
+		default:
+			// c# does not support multiple inheritance
+			properties = append(properties, g.flatten(property.PropertyType)...)
+		}
+	}
+
+	root.Commentf("", descriptor.Description)
+
+	if base != "" {
+		root.Printf("public class %s : %s", name, base)
+	} else {
+		root.Printf("public class %s", name)
+	}
+
+	root.Printf("{")
+
+	for index, property := range properties {
+		if index > 0 {
+			root.Printf("")
+		}
+
+		var (
+			field       = inflect.Camelize(property.Name)
+			fieldType   = kind(property.PropertyType)
+			initializer = ""
+		)
+
+		// the members cannot have the name of the enclosing type
+		if field == name {
+			field = field + "Value"
+		}
+
+		switch {
+		case !property.Required:
+			fieldType = optional(fieldType)
+		case !value(property.PropertyType):
+			// the required reference is assigned by the deserializer
+			initializer = " = default!;"
+		}
+
+		root.Commentf("    ", property.Description)
+		root.Printf("    [JsonPropertyName(%q)]", property.Name)
+
+		for _, annotation := range annotations(property.PropertyType, property.Required) {
+			root.Printf("    [%s]", annotation)
+		}
+
+		root.Printf("    public %s %s { get; set; }%s", fieldType, field, initializer)
+	}
+
+	if extension {
+		if len(properties) > 0 {
+			root.Printf("")
+		}
+
+		root.Printf("    [JsonExtensionData]")
+		root.Printf("    public Dictionary<string, JsonElement>? AdditionalProperties { get; set; }")
+	}
+
+	root.Printf("}")
+}
+
+func (g *SchemaGenerator) flatten(descriptor *codedom.TypeDescriptor) codedom.PropertyDescriptorCollection {
+	properties := codedom.PropertyDescriptorCollection{}
+
+	for _, property := range descriptor.Properties {
+		switch {
+		case !property.IsEmbedded:
+			properties = append(properties, property)
+		case property.PropertyType.IsClass:
+			properties = append(properties, g.flatten(property.PropertyType)...)
+		}
+	}
+
+	return properties
+}
+
+// value returns true if the c# type of the descriptor is a value type
+func value(descriptor *codedom.TypeDescriptor) bool {
+	item := element(descriptor)
+
+	switch {
+	case item.IsEnum:
+		return true
+	case item.IsPrimitive:
+		return item.Name != "string" && item.Name != "byte" && item.Name != "binary"
+	default:
+		return false
+	}
+}
+
+// annotations returns the data annotations of a value
+func annotations(descriptor *codedom.TypeDescriptor, required bool) []string {
+	var (
+		items    = []string{}
+		item     = element(descriptor)
+		metadata = item.Metadata
+	)
+
+	number := func(key string) (float64, bool) {
+		if value, ok := metadata[key].(*float64); ok && value != nil {
+			return *value, true
+		}
+
+		return 0, false
+	}
+
+	exclusive := func(key string) bool {
+		value, _ := metadata[key].(bool)
+		return value
+	}
+
+	length := func() {
+		if min, ok := number("min"); ok && min > 0 {
+			items = append(items, fmt.Sprintf("MinLength(%s)", literal(min)))
+		}
+
+		if max, ok := number("max"); ok {
+			items = append(items, fmt.Sprintf("MaxLength(%s)", literal(max)))
+		}
+	}
+
+	if required {
+		items = append(items, "Required")
+	}
+
+	switch {
+	case item.IsArray:
+		length()
+
+		if unique, ok := metadata["unique"].(bool); ok && unique {
+			items = append(items, "UniqueItems")
+		}
+	case item.IsEnum && !declared(item):
+		values := []string{}
+
+		if elements, ok := metadata["values"].([]interface{}); ok {
+			for _, element := range elements {
+				values = append(values, literal(element))
+			}
+		}
+
+		if len(values) > 0 {
+			items = append(items, fmt.Sprintf("AllowedValues(%s)", strings.Join(values, ", ")))
+		}
+	case item.IsPrimitive && item.Name == "string":
+		length()
+
+		if item.Metadata.HasPattern() {
+			items = append(items, fmt.Sprintf("RegularExpression(%s)", verbatim(fmt.Sprintf("%v", metadata["pattern"]))))
+		}
+	case item.IsPrimitive && item.Name != "boolean":
+		var (
+			min, hasMin = number("min")
+			max, hasMax = number("max")
+			// the range of the int32 values is the only one validated as integers
+			floating = item.Name != "int32"
+			lower    = "int.MinValue"
+			upper    = "int.MaxValue"
+			options  = []string{}
+		)
+
+		bound := func(value float64) string {
+			text := literal(value)
+
+			if floating && !strings.ContainsAny(text, ".e") {
+				text = text + ".0"
+			}
+
+			return text
+		}
+
+		if floating {
+			lower = "double.MinValue"
+			upper = "double.MaxValue"
+		}
+
+		if hasMin {
+			lower = bound(min)
+
+			if exclusive("min_exclusive") {
+				options = append(options, "MinimumIsExclusive = true")
+			}
+		}
+
+		if hasMax {
+			upper = bound(max)
+
+			if exclusive("max_exclusive") {
+				options = append(options, "MaximumIsExclusive = true")
+			}
+		}
+
+		if hasMin || hasMax {
+			args := append([]string{lower, upper}, options...)
+			items = append(items, fmt.Sprintf("Range(%s)", strings.Join(args, ", ")))
+		}
+
+		if factor, ok := number("multiple_of"); ok {
+			items = append(items, fmt.Sprintf("MultipleOf(%s)", bound(factor)))
+		}
+	}
+
+	return items
+}
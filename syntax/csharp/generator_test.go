@@ -0,0 +1,157 @@
+package csharp_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/syntax/csharp"
+)
+
+var _ = Describe("Generator", func() {
+	var (
+		generator *csharp.Generator
+		spec      *codedom.SpecDescriptor
+		reporter  *fake.Reporter
+		dir       string
+	)
+
+	BeforeEach(func() {
+		reporter = &fake.Reporter{}
+		reporter.WithReturns(reporter)
+
+		swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromFile("../../fixture/spec/web-api.yaml")
+		Expect(err).To(BeNil())
+
+		resolver := &codedom.Resolver{
+			Reporter: reporter,
+			Cache:    codedom.TypeDescriptorMap{},
+		}
+
+		spec, err = resolver.Resolve(swagger)
+		Expect(err).To(BeNil())
+
+		dir = tmpdir()
+
+		generator = &csharp.Generator{
+			Path:     filepath.Join(dir, "app"),
+			Reporter: reporter,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("generates the web api", func() {
+		Expect(generator.Generate(spec)).To(Succeed())
+
+		var (
+			golden = "../../fixture/code/csharp"
+			files  = []string{}
+		)
+
+		err := filepath.Walk(generator.Path, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+
+			name, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+
+			files = append(files, name)
+
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			fixture := filepath.Join(golden, name+".fixture")
+
+			if *update {
+				Expect(os.MkdirAll(filepath.Dir(fixture), 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(fixture, data, 0644)).To(Succeed())
+			}
+
+			expected, err := ioutil.ReadFile(fixture)
+			if err != nil {
+				return err
+			}
+
+			Expect(string(data)).To(Equal(string(expected)), name)
+			return nil
+		})
+
+		Expect(err).To(BeNil())
+
+		count := 0
+
+		err = filepath.Walk(golden, func(path string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() {
+				count++
+			}
+
+			return err
+		})
+
+		Expect(err).To(BeNil())
+		Expect(files).To(HaveLen(count))
+	})
+
+	It("uses the name of the directory as a namespace", func() {
+		Expect(generator.Generate(spec)).To(Succeed())
+
+		data, err := ioutil.ReadFile(filepath.Join(generator.Path, "Models", "Account.cs"))
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring("namespace App.Models;"))
+	})
+
+	Context("when the namespace is provided", func() {
+		BeforeEach(func() {
+			generator.Namespace = "Company.Bank"
+		})
+
+		It("generates the project with the namespace", func() {
+			Expect(generator.Generate(spec)).To(Succeed())
+
+			data, err := ioutil.ReadFile(filepath.Join(generator.Path, "Company.Bank.csproj"))
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(ContainSubstring("<RootNamespace>Company.Bank</RootNamespace>"))
+		})
+	})
+
+	Context("when the scaffolded files exist", func() {
+		It("does not overwrite them", func() {
+			path := filepath.Join(generator.Path, "Controllers", "AccountController.cs")
+
+			Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(path, []byte("// user code"), 0644)).To(Succeed())
+
+			Expect(generator.Generate(spec)).To(Succeed())
+
+			data, err := ioutil.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal("// user code"))
+
+			Expect(filepath.Join(generator.Path, "Controllers", "AccountControllerBase.cs")).To(BeARegularFile())
+		})
+	})
+
+	Context("when cannot create the directory", func() {
+		BeforeEach(func() {
+			generator.Path = "/proc/stride"
+		})
+
+		It("returns an error", func() {
+			Expect(generator.Generate(spec)).To(MatchError("mkdir /proc/stride: no such file or directory"))
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package csharp_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/parcello"
+)
+
+// update rewrites the golden files with the generated output
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestCSharp(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CSharp Suite")
+}
+
+var _ = BeforeSuite(func() {
+	// the templates are read from the source tree
+	parcello.Manager = parcello.Dir("../../template")
+})
+
+func tmpdir() string {
+	dir, err := ioutil.TempDir("", "example")
+	Expect(err).To(BeNil())
+	Expect(os.Remove(dir)).To(Succeed())
+	return dir
+}
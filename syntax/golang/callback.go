@@ -0,0 +1,74 @@
+package golang
+
+import (
+	"net/url"
+	"path"
+	"regexp"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/inflect"
+)
+
+var expression = regexp.MustCompile(`\{\$[^{}]*\}`)
+
+// receiversOf returns the controllers that receive the callbacks of the given
+// controllers. The callback operations are mounted at the path of their url
+// without the runtime expressions, so the subscribers register the url at
+// which the receiver is mounted.
+func receiversOf(controllers codedom.ControllerDescriptorCollection, reporter contract.Reporter) codedom.ControllerDescriptorCollection {
+	receivers := codedom.ControllerDescriptorCollection{}
+
+	for _, controller := range controllers {
+		var (
+			routes   = map[string]bool{}
+			receiver = &codedom.ControllerDescriptor{
+				Name:        controller.Name + "-callback",
+				Description: controller.Description,
+			}
+		)
+
+		for _, operation := range controller.Operations {
+			for _, callback := range operation.Callbacks {
+				for _, descriptor := range callback.Operations {
+					item := *descriptor
+					item.Path = routeOf(descriptor.Path)
+
+					key := item.Method + " " + item.Path
+
+					if routes[key] {
+						reporter := reporter.With(contract.SeverityLow)
+						reporter.Warn("ﳑ Generating callback: %s operation: %s skipped. The route %s %s is already mounted",
+							inflect.Dasherize(callback.Name),
+							inflect.Dasherize(descriptor.Name),
+							inflect.UpperCase(item.Method),
+							item.Path,
+						)
+
+						continue
+					}
+
+					routes[key] = true
+					receiver.Operations = append(receiver.Operations, &item)
+				}
+			}
+		}
+
+		if len(receiver.Operations) > 0 {
+			receivers = append(receivers, receiver)
+		}
+	}
+
+	return receivers
+}
+
+// routeOf returns the path of a callback url without the runtime expressions
+func routeOf(address string) string {
+	address = expression.ReplaceAllString(address, "")
+
+	if uri, err := url.Parse(address); err == nil {
+		address = uri.Path
+	}
+
+	return path.Join("/", address)
+}
@@ -0,0 +1,193 @@
+package golang
+
+import (
+	"mime"
+	"reflect"
+	"strings"
+
+	"github.com/phogolabs/stride/codedom"
+)
+
+// body is a body field of an operation input
+type body struct {
+	// Field is the name of the input field
+	Field string
+	// Codec is the codec of the content type (json, xml, form, multipart,
+	// text or binary). It's empty if the body is shared by all content types.
+	Codec string
+	// Codecs are the codecs of the content types that share the body
+	Codecs []string
+	// ContentType is the content type of the body
+	ContentType string
+	// Encoding maps the properties of a multipart body to their content types
+	Encoding map[string]string
+	// Request is the request that declares the body
+	Request *codedom.RequestDescriptor
+}
+
+// IsMultipart returns true if the body is sent as multipart form
+func (b *body) IsMultipart() bool {
+	return codecOf(b.ContentType) == "multipart"
+}
+
+// bodiesOf returns the body fields of an operation input. The requests share a
+// single Body field if all of them have the same body type. Otherwise every
+// codec has its own field such as JSONBody or FormBody. The content types
+// without a codec are not supported.
+func bodiesOf(requests codedom.RequestDescriptorCollection) (bodies []*body, skipped []*codedom.RequestDescriptor) {
+	var (
+		shared = true
+		prev   *codedom.RequestDescriptor
+	)
+
+	for _, request := range requests {
+		if request.RequestType == nil {
+			continue
+		}
+
+		if prev != nil && !reflect.DeepEqual(prev.RequestType, request.RequestType) {
+			shared = false
+		}
+
+		if prev == nil {
+			prev = request
+		}
+	}
+
+	if prev == nil {
+		return nil, nil
+	}
+
+	codecs := map[string]bool{}
+
+	if shared {
+		item := &body{
+			Field:       "Body",
+			ContentType: prev.ContentType,
+			Request:     prev,
+		}
+
+		for _, request := range requests {
+			codec := codecOf(request.ContentType)
+
+			switch {
+			case request.RequestType == nil:
+				continue
+			case codec == "":
+				skipped = append(skipped, request)
+				continue
+			case codec == "multipart":
+				item.Encoding = request.Encoding
+			}
+
+			if !codecs[codec] {
+				codecs[codec] = true
+				item.Codecs = append(item.Codecs, codec)
+			}
+		}
+
+		return []*body{item}, skipped
+	}
+
+	for _, request := range requests {
+		if request.RequestType == nil {
+			continue
+		}
+
+		codec := codecOf(request.ContentType)
+
+		if codec == "" || codecs[codec] {
+			skipped = append(skipped, request)
+			continue
+		}
+
+		codecs[codec] = true
+
+		item := &body{
+			Field:       fieldOf(codec),
+			Codec:       codec,
+			Codecs:      []string{codec},
+			ContentType: request.ContentType,
+			Encoding:    request.Encoding,
+			Request:     request,
+		}
+
+		bodies = append(bodies, item)
+	}
+
+	return bodies, skipped
+}
+
+// codecOf returns the codec that decodes the content type. The vendor types
+// such as application/vnd.api+json are decoded by the codec of their suffix.
+func codecOf(contentType string) string {
+	media, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+
+	switch {
+	case strings.HasSuffix(media, "json"):
+		return "json"
+	case strings.HasSuffix(media, "xml"):
+		return "xml"
+	case media == "application/x-www-form-urlencoded":
+		return "form"
+	case media == "multipart/form-data":
+		return "multipart"
+	case media == "text/plain":
+		return "text"
+	case media == "application/octet-stream":
+		return "binary"
+	default:
+		return ""
+	}
+}
+
+func fieldOf(codec string) string {
+	switch codec {
+	case "json":
+		return "JSONBody"
+	case "xml":
+		return "XMLBody"
+	case "multipart":
+		return "MultipartBody"
+	case "text":
+		return "TextBody"
+	case "binary":
+		return "BinaryBody"
+	default:
+		return "FormBody"
+	}
+}
+
+// isDecoded returns true if the body of a request is decoded by the generated
+// DecodeBody function instead of the binder. The binder decodes the JSON, XML
+// and form bodies of the standard content types.
+func isDecoded(requests codedom.RequestDescriptorCollection) bool {
+	for _, request := range requests {
+		if request.RequestType == nil {
+			continue
+		}
+
+		media, _, err := mime.ParseMediaType(request.ContentType)
+		if err != nil {
+			continue
+		}
+
+		switch codecOf(media) {
+		case "multipart", "text", "binary":
+			return true
+		case "json":
+			if media != "application/json" {
+				return true
+			}
+		case "xml":
+			if media != "application/xml" && media != "text/xml" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -6,6 +6,8 @@ import (
 	"go/token"
 	"io"
 	"os"
+	"path"
+	"strconv"
 	"strings"
 
 	"github.com/dave/dst"
@@ -42,7 +44,7 @@ func NewFile(name string) *File {
 		name: name,
 		node: &dst.File{
 			Name: &dst.Ident{
-				Name: "service",
+				Name: defaultPackage,
 			},
 			Decls: []dst.Decl{},
 		},
@@ -77,6 +79,13 @@ func (f *File) Name() string {
 	return f.name
 }
 
+// SetPackage sets the package name
+func (f *File) SetPackage(name string) {
+	f.node.Name = &dst.Ident{
+		Name: name,
+	}
+}
+
 // Node returns the node
 func (f *File) Node() *dst.File {
 	return f.node
@@ -84,10 +93,20 @@ func (f *File) Node() *dst.File {
 
 // AddImport adds an import
 func (f *File) AddImport(name string) {
+	f.AddNamedImport("", name)
+}
+
+// AddNamedImport adds an import with the given package name. The name is
+// omitted if it matches the last element of the import path.
+func (f *File) AddNamedImport(alias, name string) {
 	if name == "" {
 		return
 	}
 
+	if alias == path.Base(name) {
+		alias = ""
+	}
+
 	name = fmt.Sprintf("%q", name)
 	container := f.container()
 
@@ -106,6 +125,12 @@ func (f *File) AddImport(name string) {
 		},
 	}
 
+	if alias != "" {
+		spec.Name = &dst.Ident{
+			Name: alias,
+		}
+	}
+
 	container.Specs = append(container.Specs, spec)
 }
 
@@ -281,6 +306,17 @@ func (b *StructType) AddField(name, kind string, tags ...*codedom.TagDescriptor)
 	spec.Fields.List = append(spec.Fields.List, field)
 }
 
+// AddEmbeddedField defines an embedded field
+func (b *StructType) AddEmbeddedField(name, kind string) {
+	field := property("", kind)
+	field.Decs.Before = dst.NewLine
+	field.Decs.After = dst.NewLine
+	field.Decs.Start.Append(AnnotationGenerate.Key(name))
+
+	spec := b.node.Specs[0].(*dst.TypeSpec).Type.(*dst.StructType)
+	spec.Fields.List = append(spec.Fields.List, field)
+}
+
 // LiteralType builds a literal type
 type LiteralType struct {
 	node *dst.GenDecl
@@ -366,8 +402,8 @@ func (b *ConstBlockType) Commentf(pattern string, args ...interface{}) {
 	commentf(&b.node.Decs.Start, pattern, args...)
 }
 
-// AddConst defines a var
-func (b *ConstBlockType) AddConst(name, kind, value string) {
+// AddConst defines a const
+func (b *ConstBlockType) AddConst(name, kind string, value interface{}) {
 	field := property(name, kind)
 
 	spec := &dst.ValueSpec{
@@ -377,16 +413,11 @@ func (b *ConstBlockType) AddConst(name, kind, value string) {
 
 	spec.Decs.Before = dst.NewLine
 	spec.Decs.After = dst.EmptyLine
-	spec.Decs.Start.Append(fmt.Sprintf(docConst, inflect.Camelize(name), value))
+	spec.Decs.Start.Append(fmt.Sprintf(docConst, inflect.Camelize(name), fmt.Sprintf("%v", value)))
 	spec.Decs.Start.Append(AnnotationGenerate.Key(name))
 
-	if value != "" {
-		spec.Values = []dst.Expr{
-			&dst.BasicLit{
-				Kind:  token.STRING,
-				Value: fmt.Sprintf("%q", value),
-			},
-		}
+	if literal := literal(value); literal != nil {
+		spec.Values = []dst.Expr{literal}
 	}
 
 	b.node.Specs = append(b.node.Specs, spec)
@@ -447,6 +478,78 @@ func (b *ArrayType) Element(name string) *ArrayType {
 	return b
 }
 
+// InterfaceType builds an interface type
+type InterfaceType struct {
+	node *dst.GenDecl
+}
+
+// NewInterfaceType creates a new InterfaceType
+func NewInterfaceType(name string) *InterfaceType {
+	methods := &dst.FieldList{}
+	methods.Opening = true
+	methods.Closing = true
+
+	node := &dst.GenDecl{
+		Tok: token.TYPE,
+		Specs: []dst.Spec{
+			&dst.TypeSpec{
+				Name: &dst.Ident{
+					Name: inflect.Camelize(name),
+				},
+				Type: &dst.InterfaceType{
+					Methods: methods,
+				},
+			},
+		},
+	}
+
+	// formatting
+	node.Decs.Before = dst.EmptyLine
+	node.Decs.After = dst.EmptyLine
+	// comments
+	node.Decs.Start.Append(fmt.Sprintf(docType, inflect.Camelize(name)))
+	node.Decs.Start.Append(AnnotationGenerate.Key(name))
+
+	return &InterfaceType{
+		node: node,
+	}
+}
+
+// Node returns the node
+func (b *InterfaceType) Node() *dst.GenDecl {
+	return b.node
+}
+
+// Name returns the type name
+func (b *InterfaceType) Name() string {
+	return b.node.Specs[0].(*dst.TypeSpec).Name.Name
+}
+
+// Commentf adds a comment
+func (b *InterfaceType) Commentf(pattern string, args ...interface{}) {
+	commentf(&b.node.Decs.Start, pattern, args...)
+}
+
+// AddMethod defines a method without arguments and results
+func (b *InterfaceType) AddMethod(name string) {
+	method := &dst.Field{
+		Names: []*dst.Ident{
+			{
+				Name: name,
+			},
+		},
+		Type: &dst.FuncType{
+			Params: &dst.FieldList{},
+		},
+	}
+
+	method.Decs.Before = dst.NewLine
+	method.Decs.After = dst.NewLine
+
+	spec := b.node.Specs[0].(*dst.TypeSpec).Type.(*dst.InterfaceType)
+	spec.Methods.List = append(spec.Methods.List, method)
+}
+
 func kind(field *dst.Field) string {
 	kind := field.Type
 
@@ -467,6 +570,43 @@ func kind(field *dst.Field) string {
 	return ""
 }
 
+func literal(value interface{}) *dst.BasicLit {
+	switch item := value.(type) {
+	case nil:
+		return nil
+	case string:
+		if item == "" {
+			return nil
+		}
+
+		return &dst.BasicLit{
+			Kind:  token.STRING,
+			Value: fmt.Sprintf("%q", item),
+		}
+	case float32:
+		return literal(float64(item))
+	case float64:
+		text := strconv.FormatFloat(item, 'f', -1, 64)
+
+		if strings.Contains(text, ".") {
+			return &dst.BasicLit{
+				Kind:  token.FLOAT,
+				Value: text,
+			}
+		}
+
+		return &dst.BasicLit{
+			Kind:  token.INT,
+			Value: text,
+		}
+	default:
+		return &dst.BasicLit{
+			Kind:  token.INT,
+			Value: fmt.Sprintf("%v", item),
+		}
+	}
+}
+
 func property(name, kind string) *dst.Field {
 	const star = "*"
 
@@ -16,7 +16,19 @@ type FileGenerator interface {
 
 // Generator generates the source code
 type Generator struct {
-	Path     string
+	Path       string
+	Validation bool
+	// Module is the import path of the project directory if it's not part of
+	// a module. It's relative to GOPATH/src by default.
+	Module string
+	// GoMod scaffolds a go.mod file if the project is not part of a module
+	GoMod bool
+	// Models is the directory of the models package relative to the project
+	Models string
+	// Handlers is the directory of the handlers package relative to the project
+	Handlers string
+	// Command is the name of the command that runs the server
+	Command  string
 	Reporter contract.Reporter
 }
 
@@ -25,46 +37,108 @@ func (g *Generator) Generate(spec *codedom.SpecDescriptor) error {
 	var generator FileGenerator
 
 	reporter := g.Reporter.With(contract.SeverityVeryHigh)
-	reporter.Notice(" Generating spec...")
+	reporter.Notice(" Generating spec...")
+
+	if g.GoMod {
+		if err := g.scaffold(); err != nil {
+			reporter.Error(" Generating spec fail: %v", err)
+			return err
+		}
+	}
+
+	layout, err := g.layout()
+	if err != nil {
+		reporter.Error(" Generating spec fail: %v", err)
+		return err
+	}
+
+	var (
+		// the receivers of the callbacks are generated as controllers
+		receivers   = receiversOf(spec.Controllers, g.Reporter)
+		controllers = append(append(codedom.ControllerDescriptorCollection{}, spec.Controllers...), receivers...)
+	)
 
 	generator = &SchemaGenerator{
-		Path:       filepath.Join(g.Path, "service"),
+		Path:       layout.Models.Path,
+		Package:    layout.Models.Name,
+		Validation: g.Validation,
 		Collection: spec.Types,
 		Reporter:   g.Reporter,
 	}
 
 	// writes the schema
 	if err := g.sync(generator); err != nil {
-		reporter.Error(" Generating spec fail")
+		reporter.Error(" Generating spec fail")
+		return err
+	}
+
+	// write the custom validations
+	generator = &ValidationGenerator{
+		Path:        layout.Models.Path,
+		Package:     layout.Models.Name,
+		Validation:  g.Validation,
+		Collection:  spec.Types,
+		Controllers: controllers,
+		Reporter:    g.Reporter,
+	}
+
+	if err := g.sync(generator); err != nil {
+		reporter.Error(" Generating spec fail")
+		return err
+	}
+
+	// write the file type and the codecs
+	generator = &CodecGenerator{
+		Path:        layout.Models.Path,
+		Package:     layout.Models.Name,
+		Collection:  spec.Types,
+		Controllers: controllers,
+		Reporter:    g.Reporter,
+	}
+
+	if err := g.sync(generator); err != nil {
+		reporter.Error(" Generating spec fail")
 		return err
 	}
 
 	// write the controller's schema
-	for _, descriptor := range spec.Controllers {
+	for _, descriptor := range controllers {
 		generator = &ControllerGenerator{
 			Mode:       ControllerGeneratorModeSchema,
-			Path:       filepath.Join(g.Path, "service"),
+			Path:       layout.Models.Path,
+			Package:    layout.Models.Name,
+			Validation: g.Validation,
 			Reporter:   g.Reporter,
 			Controller: descriptor,
 		}
 
 		if err := g.sync(generator); err != nil {
-			g.Reporter.Error(" Generating spec fail")
+			g.Reporter.Error(" Generating spec fail")
 			return err
 		}
 	}
 
+	// the handlers refer to the models by the package name
+	models := &Package{}
+
+	if layout.Models.Import != layout.Handlers.Import {
+		models = layout.Models
+	}
+
 	// write the controller's api
-	for _, descriptor := range spec.Controllers {
+	for _, descriptor := range controllers {
 		generator = &ControllerGenerator{
 			Mode:       ControllerGeneratorModeAPI,
-			Path:       filepath.Join(g.Path, "service"),
+			Path:       layout.Handlers.Path,
+			Package:    layout.Handlers.Name,
+			Models:     models,
+			Validation: g.Validation,
 			Reporter:   g.Reporter,
 			Controller: descriptor,
 		}
 
 		if err := g.sync(generator); err != nil {
-			reporter.Error(" Generating spec fail")
+			reporter.Error(" Generating spec fail")
 			return err
 		}
 	}
@@ -73,52 +147,118 @@ func (g *Generator) Generate(spec *codedom.SpecDescriptor) error {
 	for _, descriptor := range spec.Controllers {
 		generator = &ControllerGenerator{
 			Mode:       ControllerGeneratorModeSpec,
-			Path:       filepath.Join(g.Path, "service"),
+			Path:       layout.Handlers.Path,
+			Package:    layout.Handlers.Name,
+			Import:     layout.Handlers.Import,
 			Reporter:   g.Reporter,
 			Controller: descriptor,
 		}
 
 		if err := g.sync(generator); err != nil {
-			reporter.Error(" Generating spec fail")
+			reporter.Error(" Generating spec fail")
 			return err
 		}
 	}
 
+	// write the authenticators of the security schemes
+	generator = &SecurityGenerator{
+		Path:     layout.Handlers.Path,
+		Package:  layout.Handlers.Name,
+		Schemes:  spec.SecuritySchemes,
+		Reporter: g.Reporter,
+	}
+
+	if err := g.sync(generator); err != nil {
+		reporter.Error(" Generating spec fail")
+		return err
+	}
+
 	// write the server
 	generator = &ServerGenerator{
-		Path:        filepath.Join(g.Path, "service"),
+		Path:        layout.Handlers.Path,
+		Package:     layout.Handlers.Name,
+		Models:      models,
+		Validations: hasCustomTags(spec.Types, controllers),
 		Controllers: spec.Controllers,
 		Reporter:    reporter,
 	}
 
 	if err := g.sync(generator); err != nil {
-		reporter.Error(" Generating spec fail")
+		reporter.Error(" Generating spec fail")
 		return err
 	}
 
+	// write the client
+	generator = &ClientGenerator{
+		Mode:     ClientGeneratorModeBase,
+		Path:     layout.Client.Path,
+		Reporter: g.Reporter,
+	}
+
+	if err := g.sync(generator); err != nil {
+		reporter.Error(" Generating spec fail")
+		return err
+	}
+
+	// write the controller's client
+	for _, descriptor := range spec.Controllers {
+		generator = &ClientGenerator{
+			Mode:       ClientGeneratorModeAPI,
+			Path:       layout.Client.Path,
+			Models:     layout.Models,
+			Validation: g.Validation,
+			Reporter:   g.Reporter,
+			Controller: descriptor,
+		}
+
+		if err := g.sync(generator); err != nil {
+			reporter.Error(" Generating spec fail")
+			return err
+		}
+	}
+
+	// write the controller's callback client
+	for _, descriptor := range spec.Controllers {
+		generator = &ClientGenerator{
+			Mode:       ClientGeneratorModeCallback,
+			Path:       layout.Client.Path,
+			Models:     layout.Models,
+			Validation: g.Validation,
+			Reporter:   g.Reporter,
+			Controller: descriptor,
+		}
+
+		if err := g.sync(generator); err != nil {
+			reporter.Error(" Generating spec fail")
+			return err
+		}
+	}
+
 	// write the application main
 	generator = &MainGenerator{
-		Path:     g.Path,
+		Path:     layout.Command.Path,
+		Handlers: layout.Handlers,
 		Reporter: g.Reporter,
 	}
 
 	if err := g.sync(generator); err != nil {
-		reporter.Error(" Generating spec fail")
+		reporter.Error(" Generating spec fail")
 		return err
 	}
 
 	// write the application suite spec
 	generator = &SpecGenerator{
-		Path:     g.Path,
+		Path:     layout.Handlers.Path,
+		Package:  layout.Handlers.Name,
 		Reporter: g.Reporter,
 	}
 
 	if err := g.sync(generator); err != nil {
-		reporter.Error(" Generating spec fail")
+		reporter.Error(" Generating spec fail")
 		return err
 	}
 
-	reporter.Success(" Generating spec complete!")
+	reporter.Success(" Generating spec complete!")
 	return nil
 }
 
@@ -0,0 +1,537 @@
+package golang
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/inflect"
+	"github.com/phogolabs/stride/syntax"
+)
+
+// ClientGeneratorMode determines the mode of this generator
+type ClientGeneratorMode byte
+
+const (
+	// ClientGeneratorModeBase generates the base client shared by the controllers
+	ClientGeneratorModeBase ClientGeneratorMode = 0
+	// ClientGeneratorModeAPI generates the client for the controller
+	ClientGeneratorModeAPI ClientGeneratorMode = 1
+	// ClientGeneratorModeCallback generates the client that sends the
+	// callbacks of the controller
+	ClientGeneratorModeCallback ClientGeneratorMode = 2
+)
+
+// ClientGenerator builds a client
+type ClientGenerator struct {
+	Path string
+	// Models is the package of the input and output types. It's the service
+	// package next to the client by default.
+	Models *Package
+	// Validation validates the bodies of the responses in their direction
+	Validation bool
+	Mode       ClientGeneratorMode
+	Controller *codedom.ControllerDescriptor
+	Reporter   contract.Reporter
+}
+
+// Generate generates a file
+func (g *ClientGenerator) Generate() *File {
+	switch g.Mode {
+	case ClientGeneratorModeBase:
+		return g.base()
+	case ClientGeneratorModeAPI:
+		return g.api()
+	case ClientGeneratorModeCallback:
+		if !g.hasCallbacks() {
+			return nil
+		}
+
+		return g.api()
+	default:
+		return nil
+	}
+}
+
+func (g *ClientGenerator) base() *File {
+	filename := filepath.Join(g.Path, "client.go")
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+	reporter.Notice(" Generating client file: %s...", filename)
+
+	writer := &syntax.TemplateWriter{
+		Path:    "syntax/golang/client.go.tpl",
+		Context: map[string]interface{}{},
+	}
+
+	buffer := &bytes.Buffer{}
+	if _, err := writer.WriteTo(buffer); err != nil {
+		reporter.Error(" Generating client file: %s fail: %v", filename, err)
+		return nil
+	}
+
+	root, err := ReadFile(filename, buffer)
+	if err != nil {
+		reporter.Error(" Generating client file: %s fail: %v", filename, err)
+		return nil
+	}
+
+	reporter.Notice(" Generating client file: %s successful", filename)
+	return root
+}
+
+func (g *ClientGenerator) api() *File {
+	var (
+		filename = filepath.Join(g.Path, g.filename())
+		root     = NewFile(filename)
+	)
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+
+	reporter.Notice(" Generating client: %s file: %s...",
+		inflect.Dasherize(g.name()),
+		root.Name(),
+	)
+
+	// the input and output types are defined by the models package
+	models, err := g.models()
+	if err != nil {
+		reporter.Error(" Generating client: %s file: %s fail: %v",
+			inflect.Dasherize(g.name()),
+			root.Name(),
+			err,
+		)
+
+		return nil
+	}
+
+	root.SetPackage("client")
+	root.AddImport("context")
+	root.AddImport("net/http")
+	root.AddNamedImport(models.Name, models.Import)
+
+	// struct
+	spec := NewStructType(g.name())
+	spec.Commentf(g.Controller.Description)
+	spec.AddField("Client", "*Client")
+	// add the spec to the file
+	root.AddNode(spec)
+
+	g.function(root, "client_new", map[string]interface{}{
+		"receiver":   spec.Name(),
+		"function":   "new",
+		"controller": g.Controller.Name,
+	})
+
+	if g.Mode == ClientGeneratorModeCallback {
+		for _, operation := range g.Controller.Operations {
+			for _, callback := range operation.Callbacks {
+				for _, operation := range callback.Operations {
+					g.operation(root, spec, operation, models)
+				}
+			}
+		}
+	} else {
+		for _, operation := range g.Controller.Operations {
+			g.operation(root, spec, operation, models)
+		}
+	}
+
+	reporter.Notice(" Generating client: %s file: %s successful",
+		inflect.Dasherize(g.name()),
+		root.Name(),
+	)
+
+	return root
+}
+
+func (g *ClientGenerator) operation(root *File, parent *StructType, operation *codedom.OperationDescriptor, models *Package) {
+	var (
+		name     = inflect.Camelize(operation.Identifier())
+		request  = &codedom.RequestDescriptor{}
+		accept   = []string{}
+		fallback = false
+	)
+
+	if len(operation.Requests) > 0 {
+		request = operation.Requests[0]
+	}
+
+	g.Reporter.Info("ﳑ Generating client: %s operation: %s...",
+		inflect.Dasherize(g.name()),
+		inflect.Dasherize(operation.Name),
+	)
+
+	bodies, skipped := bodiesOf(operation.Requests)
+
+	// the request sends the first body that is set. The bodies that can be
+	// absent come first, because the others are always set.
+	sort.SliceStable(bodies, func(i, j int) bool {
+		return nilable(bodies[i].Request.RequestType) && !nilable(bodies[j].Request.RequestType)
+	})
+
+	for _, request := range skipped {
+		reporter := g.Reporter.With(contract.SeverityLow)
+		reporter.Warn("ﳑ Generating client: %s operation: %s request content-type: %s skipped. The content-type is not supported",
+			inflect.Dasherize(g.name()),
+			inflect.Dasherize(operation.Name),
+			inflect.Dasherize(request.ContentType),
+		)
+	}
+
+	// response
+	spec := NewStructType(name + "Response")
+	spec.Commentf("It is the response of %s operation", name)
+	spec.AddField("StatusCode", "int")
+	spec.AddField("Header", "http.Header")
+	// add the spec to the file
+	root.AddNode(spec)
+
+	var (
+		codes     = map[int]bool{}
+		responses = []map[string]interface{}{}
+	)
+
+	for _, response := range operation.Responses {
+		if kind := response.ContentType; response.ResponseType != nil && !g.contains(accept, kind) {
+			accept = append(accept, kind)
+		}
+
+		// the responses with the same code share the output
+		if codes[response.Code] {
+			continue
+		}
+
+		codes[response.Code] = true
+
+		var (
+			status = inflect.Camelize(http.StatusText(response.Code))
+			field  = status
+		)
+
+		if response.Code < 0 {
+			field = "Default"
+			fallback = true
+		}
+
+		item := map[string]interface{}{
+			"code":    response.Code,
+			"default": response.Code < 0,
+			"field":   field,
+			"output":  name + status + "Output",
+			"headers": response.Parameters,
+			"body":    response.ResponseType != nil,
+			"links":   response.Links,
+		}
+
+		// the files are read into memory before the response is closed
+		if kind := response.ResponseType; kind != nil && kind.IsBinary() {
+			item["stream"] = inflect.Unpointer(kind.Kind())
+			item["pointer"] = strings.HasPrefix(kind.Kind(), "*")
+		}
+
+		item["validate"] = g.Validation && isValidatedOutput(response.ResponseType)
+
+		responses = append(responses, item)
+	}
+
+	// the default response is the last case
+	sort.SliceStable(responses, func(i, j int) bool {
+		var (
+			left  = responses[i]["code"].(int)
+			right = responses[j]["code"].(int)
+		)
+
+		if left < 0 || right < 0 {
+			return right < 0 && left >= 0
+		}
+
+		return left < right
+	})
+
+	for _, response := range responses {
+		spec.AddField(response["field"].(string), inflect.Pointer(models.Qualifier()+response["output"].(string)))
+	}
+
+	var links []map[string]interface{}
+
+	// the links of the callbacks refer to the operations of the server
+	if g.Mode == ClientGeneratorModeAPI {
+		links = g.links(spec, operation, responses, models)
+	}
+
+	// the links are resolved by the request and the response
+	if len(links) > 0 {
+		spec.AddEmbeddedField("link", "*link")
+	}
+
+	parameters := map[string]codedom.ParameterDescriptorCollection{}
+
+	for _, parameter := range request.Parameters {
+		kind := inflect.Camelize(strings.ToLower(parameter.In))
+		parameters[kind] = append(parameters[kind], parameter)
+	}
+
+	g.function(root, "client_operation", map[string]interface{}{
+		"receiver":    parent.Name(),
+		"function":    operation.Identifier(),
+		"models":      models.Qualifier(),
+		"method":      operation.Method,
+		"path":        operation.Path,
+		"description": operation.Description,
+		"summary":     operation.Summary,
+		"deprecated":  operation.DeprecationMessage(),
+		"parameters":  parameters,
+		"bodies":      bodies,
+		"accept":      accept,
+		"responses":   responses,
+		"fallback":    fallback,
+		"callback":    g.Mode == ClientGeneratorModeCallback,
+		"links":       len(links) > 0,
+	})
+
+	for _, link := range links {
+		g.function(root, "client_link", link)
+	}
+
+	g.Reporter.Success("ﳑ Generating client: %s operation: %s successful",
+		inflect.Dasherize(g.name()),
+		inflect.Dasherize(operation.Name),
+	)
+}
+
+func (g *ClientGenerator) links(parent *StructType, operation *codedom.OperationDescriptor, responses []map[string]interface{}, models *Package) []map[string]interface{} {
+	var (
+		reporter = g.Reporter.With(contract.SeverityLow)
+		names    = map[string]bool{}
+		items    = []map[string]interface{}{}
+	)
+
+	for _, response := range responses {
+		for _, link := range response["links"].(codedom.LinkDescriptorCollection) {
+			var (
+				function   = inflect.Camelize(link.Name)
+				target     = link.Operation
+				input      = inflect.Camelize(target.Identifier()) + "Input"
+				request    = &codedom.RequestDescriptor{}
+				kinds      = map[string]string{}
+				parameters = []map[string]string{}
+			)
+
+			// the links of the responses share the methods of the response
+			if names[function] {
+				reporter.Warn("ﳑ Generating client: %s operation: %s link: %s skipped. The link is already declared",
+					inflect.Dasherize(g.name()),
+					inflect.Dasherize(operation.Name),
+					link.Name,
+				)
+
+				continue
+			}
+
+			names[function] = true
+
+			if len(target.Requests) > 0 {
+				request = target.Requests[0]
+			}
+
+			keys := []string{}
+
+			for key := range link.Parameters {
+				keys = append(keys, key)
+			}
+
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				parameter := g.parameterOf(request.Parameters, key)
+
+				if parameter == nil {
+					reporter.Warn("ﳑ Generating client: %s operation: %s link: %s parameter: %s skipped. The parameter is not found",
+						inflect.Dasherize(g.name()),
+						inflect.Dasherize(operation.Name),
+						link.Name,
+						key,
+					)
+
+					continue
+				}
+
+				kind := inflect.Camelize(strings.ToLower(parameter.In))
+				kinds[kind] = input + kind
+
+				parameters = append(parameters, map[string]string{
+					"field": kind + "." + inflect.Camelize(parameter.Identifier()),
+					"value": link.Parameters[key],
+				})
+			}
+
+			if bodies, _ := bodiesOf(target.Requests); link.RequestBody != "" && len(bodies) > 0 {
+				parameters = append(parameters, map[string]string{
+					"field": bodies[0].Field,
+					"value": link.RequestBody,
+				})
+			}
+
+			items = append(items, map[string]interface{}{
+				"receiver":    parent.Name(),
+				"function":    function,
+				"operation":   operation.Name,
+				"link":        link.Name,
+				"description": link.Description,
+				"field":       response["field"],
+				"body":        response["body"],
+				"models":      models.Qualifier(),
+				"input":       input,
+				"kinds":       kinds,
+				"parameters":  parameters,
+				"client":      inflect.Camelize(link.Controller) + "Client",
+				"target":      inflect.Camelize(target.Identifier()),
+			})
+		}
+	}
+
+	return items
+}
+
+// parameterOf returns the parameter of a link by its name. The name may be
+// qualified by the location such as path.id.
+func (g *ClientGenerator) parameterOf(parameters codedom.ParameterDescriptorCollection, name string) *codedom.ParameterDescriptor {
+	location := ""
+
+	if parts := strings.SplitN(name, ".", 2); len(parts) == 2 {
+		switch parts[0] {
+		case "path", "query", "header", "cookie":
+			location, name = parts[0], parts[1]
+		}
+	}
+
+	for _, parameter := range parameters {
+		if parameter.Name == name && (location == "" || strings.EqualFold(parameter.In, location)) {
+			return parameter
+		}
+	}
+
+	return nil
+}
+
+func (g *ClientGenerator) function(root *File, name string, ctx map[string]interface{}) {
+	var (
+		receiver  = ctx["receiver"].(string)
+		operation = ctx["function"].(string)
+	)
+
+	g.Reporter.Info("ﳑ Generating type: %s function: %s...",
+		inflect.Dasherize(receiver),
+		inflect.Dasherize(operation),
+	)
+
+	writer := &syntax.TemplateWriter{
+		Path:    fmt.Sprintf("syntax/golang/%s.go.tpl", name),
+		Context: ctx,
+	}
+
+	buffer := &bytes.Buffer{}
+
+	if _, err := writer.WriteTo(buffer); err != nil {
+		g.Reporter.Error("ﳑ Generating type: %s function: %s fail: %v",
+			inflect.Dasherize(receiver),
+			inflect.Dasherize(operation),
+			err,
+		)
+
+		return
+	}
+
+	if err := root.AddFunction(buffer.String()); err != nil {
+		g.Reporter.Error("ﳑ Generating type: %s function: %s fail: %v",
+			inflect.Dasherize(receiver),
+			inflect.Dasherize(operation),
+			err,
+		)
+
+		return
+	}
+
+	g.Reporter.Success("ﳑ Generating type: %s function: %s successful",
+		inflect.Dasherize(receiver),
+		inflect.Dasherize(operation),
+	)
+}
+
+func (g *ClientGenerator) contains(items []string, item string) bool {
+	for _, value := range items {
+		if strings.EqualFold(value, item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *ClientGenerator) models() (*Package, error) {
+	if g.Models != nil {
+		return g.Models, nil
+	}
+
+	project, err := ImportPath(filepath.Dir(g.Path), "")
+	if err != nil {
+		return nil, err
+	}
+
+	models := &Package{
+		Name:   defaultPackage,
+		Import: path.Join(project, defaultPackage),
+	}
+
+	return models, nil
+}
+
+func (g *ClientGenerator) name() string {
+	name := inflect.Camelize(g.Controller.Name)
+
+	if g.Mode == ClientGeneratorModeCallback {
+		name = name + "Callback"
+	}
+
+	return name + "Client"
+}
+
+func (g *ClientGenerator) filename() string {
+	name := inflect.Underscore(g.Controller.Name)
+
+	if g.Mode == ClientGeneratorModeCallback {
+		name = name + "_callback"
+	}
+
+	return name + "_client.go"
+}
+
+func (g *ClientGenerator) hasCallbacks() bool {
+	for _, operation := range g.Controller.Operations {
+		if len(operation.Callbacks) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nilable returns true if the zero value of the type is nil
+func nilable(descriptor *codedom.TypeDescriptor) bool {
+	kind := descriptor.Kind()
+
+	for _, prefix := range []string{"*", "[]", "map[", "interface{"} {
+		if strings.HasPrefix(kind, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
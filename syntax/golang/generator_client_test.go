@@ -0,0 +1,307 @@
+package golang_test
+
+import (
+	"bytes"
+	"go/build"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/parcello"
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/syntax/golang"
+)
+
+var _ = Describe("ClientGenerator", func() {
+	var generator *golang.ClientGenerator
+
+	BeforeEach(func() {
+		reporter := &fake.Reporter{}
+		reporter.WithReturns(reporter)
+
+		generator = &golang.ClientGenerator{
+			Path:     filepath.Join(build.Default.GOPATH, "src", "example.com", "app", "client"),
+			Mode:     golang.ClientGeneratorModeAPI,
+			Reporter: reporter,
+			Controller: &codedom.ControllerDescriptor{
+				Name: "user",
+				Operations: codedom.OperationDescriptorCollection{
+					&codedom.OperationDescriptor{
+						Method: "GET",
+						Path:   "/users/{user-id}",
+						Name:   "get-user",
+						Requests: codedom.RequestDescriptorCollection{
+							&codedom.RequestDescriptor{
+								ContentType: "application/unknown",
+							},
+						},
+						Responses: codedom.ResponseDescriptorCollection{
+							&codedom.ResponseDescriptor{
+								Code:        -1,
+								ContentType: "application/json",
+								ResponseType: &codedom.TypeDescriptor{
+									Name:       "error",
+									IsClass:    true,
+									IsNullable: true,
+								},
+							},
+							&codedom.ResponseDescriptor{
+								Code:        200,
+								ContentType: "application/json",
+								ResponseType: &codedom.TypeDescriptor{
+									Name:       "user",
+									IsClass:    true,
+									IsNullable: true,
+								},
+								IsDefault: true,
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("generates the client", func() {
+		file := generator.Generate()
+		Expect(file).NotTo(BeNil())
+		Expect(filepath.Base(file.Name())).To(Equal("user_client.go"))
+
+		buffer := &bytes.Buffer{}
+		_, err := file.WriteTo(buffer)
+		Expect(err).To(BeNil())
+
+		source := buffer.String()
+		Expect(source).To(ContainSubstring("package client"))
+		Expect(source).To(ContainSubstring("\"example.com/app/service\""))
+		Expect(source).To(ContainSubstring("type UserClient struct {"))
+		Expect(source).To(ContainSubstring("Client *Client"))
+		Expect(source).To(ContainSubstring("type GetUserResponse struct {"))
+		Expect(source).To(ContainSubstring("StatusCode int"))
+		Expect(source).To(ContainSubstring("Header http.Header"))
+		Expect(source).To(MatchRegexp(`OK\s+\*service.GetUserOKOutput\s+// stride:generate default\s+Default \*service.GetUserOutput`))
+	})
+
+	Context("when the operation has parameters", func() {
+		var manager parcello.FileSystemManager
+
+		BeforeEach(func() {
+			manager = parcello.Manager
+			parcello.Manager = parcello.Dir("../../template")
+
+			request := generator.Controller.Operations[0].Requests[0]
+			request.Parameters = codedom.ParameterDescriptorCollection{
+				&codedom.ParameterDescriptor{
+					Name:     "user-id",
+					In:       "path",
+					Style:    "simple",
+					Required: true,
+					ParameterType: &codedom.TypeDescriptor{
+						Name:        "string",
+						IsPrimitive: true,
+					},
+				},
+				&codedom.ParameterDescriptor{
+					Name:    "limit",
+					In:      "query",
+					Style:   "form",
+					Explode: true,
+					ParameterType: &codedom.TypeDescriptor{
+						Name:        "int32",
+						IsPrimitive: true,
+					},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			parcello.Manager = manager
+		})
+
+		It("does not send the unset optional parameters", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+
+			buffer := &bytes.Buffer{}
+			_, err := file.WriteTo(buffer)
+			Expect(err).To(BeNil())
+
+			source := buffer.String()
+			Expect(source).To(ContainSubstring(`request.setPath("user-id", "simple", false, input.Path.UserID)`))
+			Expect(source).To(ContainSubstring(`request.setQuery("limit", "form", true, optional(input.Query.Limit))`))
+		})
+	})
+
+	Context("when the mode is ClientGeneratorModeCallback", func() {
+		var manager parcello.FileSystemManager
+
+		BeforeEach(func() {
+			manager = parcello.Manager
+			parcello.Manager = parcello.Dir("../../template")
+
+			generator.Mode = golang.ClientGeneratorModeCallback
+		})
+
+		AfterEach(func() {
+			parcello.Manager = manager
+		})
+
+		It("does not generate the client without callbacks", func() {
+			Expect(generator.Generate()).To(BeNil())
+		})
+
+		It("generates the callback client", func() {
+			operation := generator.Controller.Operations[0]
+			operation.Callbacks = codedom.CallbackDescriptorCollection{
+				&codedom.CallbackDescriptor{
+					Name: "onUpdate",
+					Operations: codedom.OperationDescriptorCollection{
+						&codedom.OperationDescriptor{
+							Method: "POST",
+							Path:   "{$request.query.callbackUrl}",
+							Name:   "get-user-on-update",
+							Requests: codedom.RequestDescriptorCollection{
+								&codedom.RequestDescriptor{
+									ContentType: "application/json",
+									RequestType: &codedom.TypeDescriptor{
+										Name:       "user",
+										IsClass:    true,
+										IsNullable: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+			Expect(filepath.Base(file.Name())).To(Equal("user_callback_client.go"))
+
+			buffer := &bytes.Buffer{}
+			_, err := file.WriteTo(buffer)
+			Expect(err).To(BeNil())
+
+			source := buffer.String()
+			Expect(source).To(ContainSubstring("type UserCallbackClient struct {"))
+			Expect(source).To(ContainSubstring("func (x *UserCallbackClient) GetUserOnUpdate(ctx context.Context, url string, input *service.GetUserOnUpdateInput) (*GetUserOnUpdateResponse, error)"))
+			Expect(source).To(ContainSubstring("request.url = url"))
+			Expect(source).NotTo(ContainSubstring("GetUser(ctx"))
+		})
+	})
+
+	Context("when the responses have links", func() {
+		var manager parcello.FileSystemManager
+
+		BeforeEach(func() {
+			manager = parcello.Manager
+			parcello.Manager = parcello.Dir("../../template")
+
+			target := &codedom.OperationDescriptor{
+				Method: "DELETE",
+				Path:   "/users/{user-id}",
+				Name:   "delete-user",
+				Requests: codedom.RequestDescriptorCollection{
+					&codedom.RequestDescriptor{
+						Parameters: codedom.ParameterDescriptorCollection{
+							&codedom.ParameterDescriptor{
+								Name: "user-id",
+								In:   "path",
+								ParameterType: &codedom.TypeDescriptor{
+									Name:        "string",
+									IsPrimitive: true,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			operation := generator.Controller.Operations[0]
+			operation.Responses[1].Links = codedom.LinkDescriptorCollection{
+				&codedom.LinkDescriptor{
+					Name:        "DeleteUser",
+					OperationID: "deleteUser",
+					Controller:  "user",
+					Operation:   target,
+					Parameters: map[string]string{
+						"user-id": "$request.path.user-id",
+					},
+				},
+			}
+
+			generator.Controller.Operations = append(generator.Controller.Operations, target)
+		})
+
+		AfterEach(func() {
+			parcello.Manager = manager
+		})
+
+		It("generates the link methods", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+
+			buffer := &bytes.Buffer{}
+			_, err := file.WriteTo(buffer)
+			Expect(err).To(BeNil())
+
+			source := buffer.String()
+			Expect(source).To(MatchRegexp(`type GetUserResponse struct {[^}]*\*link`))
+			Expect(source).To(ContainSubstring("func (x *GetUserResponse) DeleteUser(ctx context.Context) (*DeleteUserResponse, error)"))
+			Expect(source).To(ContainSubstring(`link.set("$request.path.user-id", &input.Path.UserID)`))
+			Expect(source).To(ContainSubstring("return NewUserClient(link.client).DeleteUser(ctx, input)"))
+		})
+	})
+
+	Context("when the responses have write-only properties", func() {
+		var manager parcello.FileSystemManager
+
+		BeforeEach(func() {
+			manager = parcello.Manager
+			parcello.Manager = parcello.Dir("../../template")
+
+			generator.Validation = true
+
+			response := generator.Controller.Operations[0].Responses[1]
+			response.ResponseType.Properties = codedom.PropertyDescriptorCollection{
+				&codedom.PropertyDescriptor{
+					Name:      "password",
+					WriteOnly: true,
+					PropertyType: &codedom.TypeDescriptor{
+						Name:        "string",
+						IsPrimitive: true,
+					},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			parcello.Manager = manager
+		})
+
+		It("validates the responses", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+
+			buffer := &bytes.Buffer{}
+			_, err := file.WriteTo(buffer)
+			Expect(err).To(BeNil())
+
+			source := buffer.String()
+			Expect(source).To(ContainSubstring("if err := output.OK.ValidateResponse(); err != nil {"))
+		})
+	})
+
+	Context("when the mode is unknown", func() {
+		BeforeEach(func() {
+			generator.Mode = golang.ClientGeneratorMode(255)
+		})
+
+		It("does not generate the client", func() {
+			Expect(generator.Generate()).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package golang
+
+import (
+	"bytes"
+	"path/filepath"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/syntax"
+)
+
+// CodecGenerator builds the file type and the codecs of the bodies that the
+// binder does not support
+type CodecGenerator struct {
+	Path        string
+	Package     string
+	Collection  codedom.TypeDescriptorCollection
+	Controllers codedom.ControllerDescriptorCollection
+	Reporter    contract.Reporter
+}
+
+// Generate generates a file
+func (g *CodecGenerator) Generate() *File {
+	filename := filepath.Join(g.Path, "codec.go")
+
+	// the codecs are needed only by the binary types and the bodies that the
+	// binder does not support
+	if !g.hasCodecs() {
+		return nil
+	}
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+	reporter.Notice(" Generating codec file: %s...", filename)
+
+	writer := &syntax.TemplateWriter{
+		Path: "syntax/golang/codec.go.tpl",
+		Context: map[string]interface{}{
+			"package": packageOf(g.Package),
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	if _, err := writer.WriteTo(buffer); err != nil {
+		reporter.Error(" Generating codec file: %s fail: %v", filename, err)
+		return nil
+	}
+
+	root, err := ReadFile(filename, buffer)
+	if err != nil {
+		reporter.Error(" Generating codec file: %s fail: %v", filename, err)
+		return nil
+	}
+
+	reporter.Notice(" Generating codec file: %s successful", filename)
+	return root
+}
+
+func (g *CodecGenerator) hasCodecs() bool {
+	visited := map[*codedom.TypeDescriptor]bool{}
+
+	for _, descriptor := range g.Collection {
+		if g.hasBinary(descriptor, visited) {
+			return true
+		}
+	}
+
+	for _, controller := range g.Controllers {
+		for _, operation := range controller.Operations {
+			if isDecoded(operation.Requests) {
+				return true
+			}
+
+			for _, request := range operation.Requests {
+				if g.hasBinary(request.RequestType, visited) {
+					return true
+				}
+			}
+
+			for _, response := range operation.Responses {
+				if response.ResponseType == nil {
+					continue
+				}
+
+				// the plain text is encoded by the codecs
+				if codecOf(response.ContentType) == "text" || g.hasBinary(response.ResponseType, visited) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func (g *CodecGenerator) hasBinary(descriptor *codedom.TypeDescriptor, visited map[*codedom.TypeDescriptor]bool) bool {
+	// the classes can refer to themselves
+	if descriptor == nil || visited[descriptor] {
+		return false
+	}
+
+	visited[descriptor] = true
+
+	if descriptor.IsBinary() {
+		return true
+	}
+
+	if g.hasBinary(descriptor.Key, visited) || g.hasBinary(descriptor.Element, visited) {
+		return true
+	}
+
+	for _, property := range descriptor.Properties {
+		if g.hasBinary(property.PropertyType, visited) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,128 @@
+package golang_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/syntax/golang"
+)
+
+var _ = Describe("CodecGenerator", func() {
+	var generator *golang.CodecGenerator
+
+	BeforeEach(func() {
+		reporter := &fake.Reporter{}
+		reporter.WithReturns(reporter)
+
+		generator = &golang.CodecGenerator{
+			Path:     tmpdir(),
+			Reporter: reporter,
+			Collection: codedom.TypeDescriptorCollection{
+				&codedom.TypeDescriptor{
+					Name:    "User",
+					IsClass: true,
+					Properties: codedom.PropertyDescriptorCollection{
+						&codedom.PropertyDescriptor{
+							Name: "name",
+							PropertyType: &codedom.TypeDescriptor{
+								Name:        "string",
+								IsPrimitive: true,
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Context("when the types do not have files", func() {
+		It("does not generate the file", func() {
+			Expect(generator.Generate()).To(BeNil())
+
+			reporter := generator.Reporter.(*fake.Reporter)
+			Expect(reporter.NoticeCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the properties are binary", func() {
+		BeforeEach(func() {
+			generator.Collection[0].Properties[0].PropertyType = &codedom.TypeDescriptor{
+				Name:    "avatars",
+				IsArray: true,
+				Element: &codedom.TypeDescriptor{
+					Name:        "binary",
+					IsPrimitive: true,
+				},
+			}
+		})
+
+		It("generates the file", func() {
+			generator.Generate()
+
+			reporter := generator.Reporter.(*fake.Reporter)
+			Expect(reporter.NoticeCallCount()).NotTo(BeZero())
+		})
+	})
+
+	Context("when the responses are plain text", func() {
+		BeforeEach(func() {
+			generator.Controllers = codedom.ControllerDescriptorCollection{
+				&codedom.ControllerDescriptor{
+					Name: "user",
+					Operations: codedom.OperationDescriptorCollection{
+						&codedom.OperationDescriptor{
+							Name: "get-user-name",
+							Responses: codedom.ResponseDescriptorCollection{
+								&codedom.ResponseDescriptor{
+									Code:        200,
+									ContentType: "text/plain",
+									ResponseType: &codedom.TypeDescriptor{
+										Name:        "string",
+										IsPrimitive: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("generates the file", func() {
+			generator.Generate()
+
+			reporter := generator.Reporter.(*fake.Reporter)
+			Expect(reporter.NoticeCallCount()).NotTo(BeZero())
+		})
+	})
+
+	Context("when the requests are multipart", func() {
+		BeforeEach(func() {
+			generator.Controllers = codedom.ControllerDescriptorCollection{
+				&codedom.ControllerDescriptor{
+					Name: "user",
+					Operations: codedom.OperationDescriptorCollection{
+						&codedom.OperationDescriptor{
+							Name: "create-user",
+							Requests: codedom.RequestDescriptorCollection{
+								&codedom.RequestDescriptor{
+									ContentType: "multipart/form-data",
+									RequestType: generator.Collection[0],
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("generates the file", func() {
+			generator.Generate()
+
+			reporter := generator.Reporter.(*fake.Reporter)
+			Expect(reporter.NoticeCallCount()).NotTo(BeZero())
+		})
+	})
+})
@@ -3,7 +3,6 @@ package golang
 import (
 	"bytes"
 	"fmt"
-	"go/build"
 	"net/http"
 	"path/filepath"
 	"strings"
@@ -28,7 +27,15 @@ const (
 
 // ControllerGenerator builds a controller
 type ControllerGenerator struct {
-	Path       string
+	Path    string
+	Package string
+	// Import is the import path of the package. It's derived from go.mod by default.
+	Import string
+	// Models is the package of the models if they are not in the same package
+	Models *Package
+	// Validation generates the methods that validate the bodies in the
+	// direction of the request and the response
+	Validation bool
 	Mode       ControllerGeneratorMode
 	Controller *codedom.ControllerDescriptor
 	Reporter   contract.Reporter
@@ -41,6 +48,8 @@ func (g *ControllerGenerator) Generate() *File {
 		root     = NewFile(filename)
 	)
 
+	root.SetPackage(packageOf(g.Package))
+
 	reporter := g.Reporter.With(contract.SeverityHigh)
 
 	reporter.Notice(" Generating controller: %s file: %s...",
@@ -72,7 +81,7 @@ func (g *ControllerGenerator) schema(root *File) {
 	defer reporter.Success("ﳑ Generating controller: %s schema successful", inflect.Dasherize(g.name()))
 
 	for _, operation := range g.Controller.Operations {
-		name := inflect.Camelize(operation.Name)
+		name := inflect.Camelize(operation.Identifier())
 
 		g.Reporter.Info("ﳑ Generating controller: %s operation: %s schema...",
 			inflect.Dasherize(g.name()),
@@ -84,16 +93,11 @@ func (g *ControllerGenerator) schema(root *File) {
 			inflect.Dasherize(operation.Name),
 		)
 
-		for index, request := range operation.Requests {
-			reporter := g.Reporter.With(contract.SeverityLow)
-
-			if index > 0 {
-				reporter.Warn("ﳑ Generating request content-type: %s skipped. More than one request per operation is not supported",
-					inflect.Dasherize(request.ContentType),
-				)
-
-				continue
-			}
+		if len(operation.Requests) > 0 {
+			var (
+				request  = operation.Requests[0]
+				reporter = g.Reporter.With(contract.SeverityLow)
+			)
 
 			// input
 			input := NewStructType(name + "Input")
@@ -101,6 +105,7 @@ func (g *ControllerGenerator) schema(root *File) {
 			// add the input to the file
 			root.AddNode(input)
 
+			// the parameters are shared by all content types
 			// path input
 			g.param("Path", root, input, request.Parameters)
 			// query input
@@ -110,28 +115,63 @@ func (g *ControllerGenerator) schema(root *File) {
 			// cookie input
 			g.param("Cookie", root, input, request.Parameters)
 
-			if request.RequestType != nil {
+			bodies, skipped := bodiesOf(operation.Requests)
+
+			for _, request := range skipped {
+				reporter.Warn("ﳑ Generating request content-type: %s skipped. The content-type is not supported",
+					inflect.Dasherize(request.ContentType),
+				)
+			}
+
+			decoders := []map[string]interface{}{}
+
+			for _, body := range bodies {
+				kind := body.Request.RequestType
+
 				reporter.Info("ﳑ Generating type: %s field: %s content-type: %s...",
 					inflect.Dasherize(input.Name()),
-					inflect.Dasherize("body"),
-					inflect.Dasherize(request.ContentType),
+					inflect.Dasherize(body.Field),
+					inflect.Dasherize(body.ContentType),
 				)
 
-				// input body
-				input.AddField("Body", request.RequestType.Kind(), g.tagOfArg("Body"), g.tagOfArg("Form"))
+				// add a import if needed
+				root.AddNamedImport(kind.ImportName(), kind.Namespace())
+
+				switch body.Codec {
+				case "":
+					// input body shared by all content types
+					input.AddField(body.Field, kind.Kind(), g.tagOfArg("Body"), g.tagOfArg("Form"))
+				case "form", "multipart":
+					input.AddField(body.Field, kind.Kind(), g.tagOfArg("Form"))
+				default:
+					input.AddField(body.Field, kind.Kind(), g.tagOfArg("Body"))
+				}
+
+				// the form bodies are decoded by the binder
+				for _, codec := range body.Codecs {
+					if codec != "form" {
+						decoders = append(decoders, g.decoder(codec, body))
+					}
+				}
 
 				reporter.Info("ﳑ Generating type: %s field: %s content-type: %s successful",
 					inflect.Dasherize(input.Name()),
-					inflect.Dasherize("body"),
-					inflect.Dasherize(request.ContentType),
+					inflect.Dasherize(body.Field),
+					inflect.Dasherize(body.ContentType),
 				)
+			}
 
+			if len(decoders) > 0 {
 				g.function(root, "decode", map[string]interface{}{
 					"receiver": input.Name(),
 					"function": "decode",
-					"body":     request.RequestType.Name,
+					"decoders": decoders,
 				})
 			}
+
+			if g.Validation {
+				g.validate(root, input.Name(), ValidateRequest, fieldsOf(bodies))
+			}
 		}
 
 		g.Reporter.Success("ﳑ Generating controller: %s operation: %s schema input successful",
@@ -144,9 +184,18 @@ func (g *ControllerGenerator) schema(root *File) {
 			inflect.Dasherize(operation.Name),
 		)
 
+		codes := map[int]bool{}
+
 		for _, response := range operation.Responses {
 			reporter := g.Reporter.With(contract.SeverityLow)
 
+			// the responses with the same code share the output
+			if codes[response.Code] {
+				continue
+			}
+
+			codes[response.Code] = true
+
 			// output
 			output := NewStructType(name + inflect.Camelize(http.StatusText(response.Code)) + "Output")
 			output.Commentf("It is the output of %s operation with code: %d", name, response.Code)
@@ -164,14 +213,33 @@ func (g *ControllerGenerator) schema(root *File) {
 			)
 
 			if response.ResponseType != nil {
+				// add a import if needed
+				root.AddNamedImport(response.ResponseType.ImportName(), response.ResponseType.Namespace())
 				// output body
 				output.AddField("Body", response.ResponseType.Kind(), g.tagOfArg("Body"))
 
-				g.function(root, "encode", map[string]interface{}{
-					"receiver": output.Name(),
-					"function": "encode",
-					"body":     response.ResponseType.Name,
-				})
+				if kind := response.ResponseType; kind.IsBinary() {
+					// the files are streamed instead of marshaled
+					g.function(root, "stream", map[string]interface{}{
+						"receiver": output.Name(),
+						"function": "stream",
+						"content":  response.ContentType,
+						"pointer":  strings.HasPrefix(kind.Kind(), "*"),
+					})
+				} else if encoders := g.encoders(operation.Responses, response.Code); len(encoders) > 0 {
+					g.function(root, "encode", map[string]interface{}{
+						"receiver": output.Name(),
+						"function": "encode",
+						"body":     kind.Identifier(),
+						"encoders": encoders,
+					})
+				}
+
+				if g.Validation {
+					g.validate(root, output.Name(), ValidateResponse, map[string]*codedom.TypeDescriptor{
+						"Body": response.ResponseType,
+					})
+				}
 			}
 
 			reporter.Info("ﳑ Generating type: %s field: %s content-type: %s code: %d successful",
@@ -235,10 +303,10 @@ func (g *ControllerGenerator) param(kind string, root *File, parent *StructType,
 			)
 
 			// add a import if needed
-			root.AddImport(param.ParameterType.Namespace())
+			root.AddNamedImport(param.ParameterType.ImportName(), param.ParameterType.Namespace())
 
 			// add a field
-			spec.AddField(param.Name, param.ParameterType.Kind(), param.Tags()...)
+			spec.AddField(param.Identifier(), param.ParameterType.Kind(), param.Tags()...)
 
 			reporter.Success("ﳑ Generating type: %s field: %s success...",
 				inflect.Dasherize(spec.Name()),
@@ -277,9 +345,19 @@ func (g *ControllerGenerator) controller(root *File) {
 	root.AddImport("github.com/phogolabs/restify")
 	root.AddImport("net/http")
 
+	if g.Models.Qualifier() != "" {
+		root.AddNamedImport(g.Models.Name, g.Models.Import)
+	}
+
 	// struct
 	spec := NewStructType(g.name())
 	spec.Commentf(g.Controller.Description)
+
+	// the secured operations are authenticated by the authenticator
+	if g.Controller.IsSecured() {
+		spec.AddField("Authenticator", "Authenticator")
+	}
+
 	// add the spec to the file
 	root.AddNode(spec)
 
@@ -294,12 +372,15 @@ func (g *ControllerGenerator) controller(root *File) {
 	for _, operation := range g.Controller.Operations {
 		g.function(root, "operation", map[string]interface{}{
 			"receiver":    spec.Name(),
-			"function":    operation.Name,
+			"function":    operation.Identifier(),
+			"models":      g.Models.Qualifier(),
 			"method":      operation.Method,
 			"path":        operation.Path,
 			"description": operation.Description,
 			"summary":     operation.Summary,
 			"deprecated":  operation.DeprecationMessage(),
+			"decode":      isDecoded(operation.Requests),
+			"validate":    g.Validation && isValidated(operation.Requests),
 		})
 	}
 }
@@ -307,13 +388,20 @@ func (g *ControllerGenerator) controller(root *File) {
 func (g *ControllerGenerator) spec(root *File) {
 	g.Reporter.Info("ﳑ Generating tests: %s...", root.Name())
 
-	project, err := filepath.Rel(filepath.Join(build.Default.GOPATH, "src"), g.Path)
-	if err != nil {
-		g.Reporter.Error(" Generating markdown documentation fail: ", err)
-		return
+	project := g.Import
+
+	if project == "" {
+		path, err := ImportPath(g.Path, "")
+		if err != nil {
+			g.Reporter.Error("ﳑ Generating tests: %s fail: %v", root.Name(), err)
+			return
+		}
+
+		project = path
 	}
 
 	ctx := map[string]interface{}{
+		"package":    packageOf(g.Package),
 		"receiver":   inflect.Camelize(g.Controller.Name) + "API",
 		"project":    project,
 		"operations": g.Controller.Operations,
@@ -384,6 +472,30 @@ func (g *ControllerGenerator) function(root *File, name string, ctx map[string]i
 	)
 }
 
+// validate generates the method that validates the bodies of an input or an
+// output if they have anything to validate
+func (g *ControllerGenerator) validate(root *File, receiver string, direction ValidateDirection, fields map[string]*codedom.TypeDescriptor) {
+	body := validateBodies(direction, fields)
+
+	if body == "" {
+		return
+	}
+
+	directed := false
+
+	for _, kind := range fields {
+		directed = directed || directional(kind)
+	}
+
+	g.function(root, "validate", map[string]interface{}{
+		"receiver":    receiver,
+		"function":    inflect.Dasherize(direction.Method()),
+		"method":      direction.Method(),
+		"directional": directed,
+		"body":        body,
+	})
+}
+
 func (g *ControllerGenerator) filename() string {
 	name := inflect.Underscore(g.Controller.Name) + "_api"
 
@@ -404,6 +516,53 @@ func (g *ControllerGenerator) name() string {
 	return name
 }
 
+func (g *ControllerGenerator) decoder(codec string, body *body) map[string]interface{} {
+	return map[string]interface{}{
+		"codec":    codec,
+		"field":    body.Field,
+		"body":     body.Request.RequestType.Identifier(),
+		"encoding": body.Encoding,
+	}
+}
+
+// encoders returns the codecs of the responses with the given code
+func (g *ControllerGenerator) encoders(responses codedom.ResponseDescriptorCollection, code int) []string {
+	var (
+		codecs   = []string{}
+		reporter = g.Reporter.With(contract.SeverityLow)
+	)
+
+	for _, response := range responses {
+		if response.Code != code || response.ResponseType == nil {
+			continue
+		}
+
+		switch codec := codecOf(response.ContentType); codec {
+		case "json", "xml", "text":
+			if !g.contains(codecs, codec) {
+				codecs = append(codecs, codec)
+			}
+		default:
+			reporter.Warn("ﳑ Generating response content-type: %s code: %d skipped. The content-type is not supported",
+				inflect.Dasherize(response.ContentType),
+				response.Code,
+			)
+		}
+	}
+
+	return codecs
+}
+
+func (g *ControllerGenerator) contains(items []string, item string) bool {
+	for _, value := range items {
+		if value == item {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (g *ControllerGenerator) tagOfArg(kind string) *codedom.TagDescriptor {
 	return &codedom.TagDescriptor{
 		Key:  strings.ToLower(kind),
@@ -2,10 +2,12 @@ package golang_test
 
 import (
 	"bytes"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/phogolabs/parcello"
 	"github.com/phogolabs/stride/codedom"
 	"github.com/phogolabs/stride/fake"
 	"github.com/phogolabs/stride/syntax/golang"
@@ -221,6 +223,145 @@ var _ = Describe("GeneratorController", func() {
 				Expect(buffer.String()).To(ContainSubstring("type SearchUserInput struct"))
 				Expect(buffer.String()).To(ContainSubstring("Body *SearchQuery `body:\"~\" form:\"~\"`"))
 			})
+
+			Context("when the content types have different bodies", func() {
+				It("generates a body per content type", func() {
+					generator.Controller = &codedom.ControllerDescriptor{
+						Name: "User",
+						Operations: codedom.OperationDescriptorCollection{
+							&codedom.OperationDescriptor{
+								Method: "POST",
+								Path:   "/users/search",
+								Name:   "search-user",
+								Requests: codedom.RequestDescriptorCollection{
+									&codedom.RequestDescriptor{
+										ContentType: "application/json",
+										RequestType: &codedom.TypeDescriptor{
+											Name:       "SearchQuery",
+											IsClass:    true,
+											IsNullable: true,
+										},
+									},
+									&codedom.RequestDescriptor{
+										ContentType: "application/x-www-form-urlencoded",
+										RequestType: &codedom.TypeDescriptor{
+											Name:       "SearchForm",
+											IsClass:    true,
+											IsNullable: true,
+										},
+									},
+									&codedom.RequestDescriptor{
+										ContentType: "text/csv",
+										RequestType: &codedom.TypeDescriptor{
+											Name:        "string",
+											IsPrimitive: true,
+										},
+									},
+								},
+							},
+						},
+					}
+
+					file := generator.Generate()
+					Expect(file).NotTo(BeNil())
+
+					buffer := &bytes.Buffer{}
+					_, err := file.WriteTo(buffer)
+					Expect(err).To(BeNil())
+
+					Expect(buffer.String()).To(ContainSubstring("type SearchUserInput struct"))
+					Expect(buffer.String()).To(ContainSubstring("JSONBody *SearchQuery `body:\"~\"`"))
+					Expect(buffer.String()).To(ContainSubstring("FormBody *SearchForm `form:\"~\"`"))
+					Expect(buffer.String()).NotTo(ContainSubstring("\tBody "))
+				})
+			})
+
+			Context("when the content types are plain text and binary", func() {
+				It("generates the text and binary bodies", func() {
+					generator.Controller = &codedom.ControllerDescriptor{
+						Name: "User",
+						Operations: codedom.OperationDescriptorCollection{
+							&codedom.OperationDescriptor{
+								Method: "PUT",
+								Path:   "/users/{user-id}/bio",
+								Name:   "update-bio",
+								Requests: codedom.RequestDescriptorCollection{
+									&codedom.RequestDescriptor{
+										ContentType: "application/octet-stream",
+										RequestType: &codedom.TypeDescriptor{
+											Name:        "binary",
+											IsPrimitive: true,
+										},
+									},
+									&codedom.RequestDescriptor{
+										ContentType: "text/plain",
+										RequestType: &codedom.TypeDescriptor{
+											Name:        "string",
+											IsPrimitive: true,
+										},
+									},
+								},
+							},
+						},
+					}
+
+					file := generator.Generate()
+					Expect(file).NotTo(BeNil())
+
+					buffer := &bytes.Buffer{}
+					_, err := file.WriteTo(buffer)
+					Expect(err).To(BeNil())
+
+					Expect(buffer.String()).To(ContainSubstring("BinaryBody *File `body:\"~\"`"))
+					Expect(buffer.String()).To(ContainSubstring("TextBody string `body:\"~\"`"))
+				})
+			})
+
+			Context("when the content type is multipart", func() {
+				It("generates a multipart body", func() {
+					generator.Controller = &codedom.ControllerDescriptor{
+						Name: "User",
+						Operations: codedom.OperationDescriptorCollection{
+							&codedom.OperationDescriptor{
+								Method: "POST",
+								Path:   "/users/{user-id}/avatar",
+								Name:   "upload-avatar",
+								Requests: codedom.RequestDescriptorCollection{
+									&codedom.RequestDescriptor{
+										ContentType: "application/json",
+										RequestType: &codedom.TypeDescriptor{
+											Name:       "AvatarLink",
+											IsClass:    true,
+											IsNullable: true,
+										},
+									},
+									&codedom.RequestDescriptor{
+										ContentType: "multipart/form-data",
+										Encoding: map[string]string{
+											"image": "image/png",
+										},
+										RequestType: &codedom.TypeDescriptor{
+											Name:       "AvatarUpload",
+											IsClass:    true,
+											IsNullable: true,
+										},
+									},
+								},
+							},
+						},
+					}
+
+					file := generator.Generate()
+					Expect(file).NotTo(BeNil())
+
+					buffer := &bytes.Buffer{}
+					_, err := file.WriteTo(buffer)
+					Expect(err).To(BeNil())
+
+					Expect(buffer.String()).To(ContainSubstring("JSONBody *AvatarLink `body:\"~\"`"))
+					Expect(buffer.String()).To(ContainSubstring("MultipartBody *AvatarUpload `form:\"~\"`"))
+				})
+			})
 		})
 
 		Describe("output", func() {
@@ -310,6 +451,115 @@ var _ = Describe("GeneratorController", func() {
 				Expect(buffer.String()).To(ContainSubstring("type GetUserByIDOutput struct"))
 				Expect(buffer.String()).To(ContainSubstring("Body *User `body:\"~\"`"))
 			})
+
+			Context("when the body has many content types", func() {
+				var manager parcello.FileSystemManager
+
+				BeforeEach(func() {
+					manager = parcello.Manager
+					parcello.Manager = parcello.Dir("../../template")
+				})
+
+				AfterEach(func() {
+					parcello.Manager = manager
+				})
+
+				It("generates a marshaler per content type", func() {
+					kind := &codedom.TypeDescriptor{
+						Name:       "User",
+						IsClass:    true,
+						IsNullable: true,
+					}
+
+					generator.Controller = &codedom.ControllerDescriptor{
+						Name: "User",
+						Operations: codedom.OperationDescriptorCollection{
+							&codedom.OperationDescriptor{
+								Method: "GET",
+								Path:   "/users/{user-id}",
+								Name:   "get-user",
+								Responses: codedom.ResponseDescriptorCollection{
+									&codedom.ResponseDescriptor{
+										Code:         200,
+										ContentType:  "application/vnd.api+json",
+										ResponseType: kind,
+									},
+									&codedom.ResponseDescriptor{
+										Code:         200,
+										ContentType:  "text/csv",
+										ResponseType: kind,
+									},
+								},
+							},
+						},
+					}
+
+					file := generator.Generate()
+					Expect(file).NotTo(BeNil())
+
+					buffer := &bytes.Buffer{}
+					_, err := file.WriteTo(buffer)
+					Expect(err).To(BeNil())
+
+					Expect(strings.Count(buffer.String(), "type GetUserOKOutput struct")).To(Equal(1))
+					Expect(buffer.String()).To(ContainSubstring("func (x GetUserOKOutput) MarshalJSON() ([]byte, error)"))
+					Expect(buffer.String()).NotTo(ContainSubstring("MarshalXML"))
+
+					reporter := generator.Reporter.(*fake.Reporter)
+					Expect(reporter.WarnCallCount()).To(Equal(1))
+
+					message, _ := reporter.WarnArgsForCall(0)
+					Expect(message).To(ContainSubstring("The content-type is not supported"))
+				})
+			})
+
+			Context("when the body is binary", func() {
+				var manager parcello.FileSystemManager
+
+				BeforeEach(func() {
+					manager = parcello.Manager
+					parcello.Manager = parcello.Dir("../../template")
+				})
+
+				AfterEach(func() {
+					parcello.Manager = manager
+				})
+
+				It("generates a streamed body", func() {
+					generator.Controller = &codedom.ControllerDescriptor{
+						Name: "User",
+						Operations: codedom.OperationDescriptorCollection{
+							&codedom.OperationDescriptor{
+								Method: "GET",
+								Path:   "/users/{user-id}/avatar",
+								Name:   "get-avatar",
+								Responses: codedom.ResponseDescriptorCollection{
+									&codedom.ResponseDescriptor{
+										Code:        200,
+										ContentType: "image/png",
+										ResponseType: &codedom.TypeDescriptor{
+											Name:        "binary",
+											IsPrimitive: true,
+										},
+									},
+								},
+							},
+						},
+					}
+
+					file := generator.Generate()
+					Expect(file).NotTo(BeNil())
+
+					buffer := &bytes.Buffer{}
+					_, err := file.WriteTo(buffer)
+					Expect(err).To(BeNil())
+
+					Expect(buffer.String()).To(ContainSubstring("Body *File `body:\"~\"`"))
+					Expect(buffer.String()).To(ContainSubstring("func (x GetAvatarOKOutput) WriteTo(w io.Writer) (int64, error)"))
+					Expect(buffer.String()).To(ContainSubstring("return \"image/png\""))
+					Expect(buffer.String()).NotTo(ContainSubstring("MarshalJSON"))
+				})
+			})
 		})
 	})
 
@@ -317,6 +567,162 @@ var _ = Describe("GeneratorController", func() {
 		BeforeEach(func() {
 			generator.Mode = golang.ControllerGeneratorModeAPI
 		})
+
+		Context("when the operations are secured", func() {
+			var manager parcello.FileSystemManager
+
+			BeforeEach(func() {
+				manager = parcello.Manager
+				parcello.Manager = parcello.Dir("../../template")
+			})
+
+			AfterEach(func() {
+				parcello.Manager = manager
+			})
+
+			It("authenticates the secured operations", func() {
+				generator.Controller = &codedom.ControllerDescriptor{
+					Name: "User",
+					Operations: codedom.OperationDescriptorCollection{
+						&codedom.OperationDescriptor{
+							Method: "GET",
+							Path:   "/users",
+							Name:   "get-users",
+						},
+						&codedom.OperationDescriptor{
+							Method: "DELETE",
+							Path:   "/users/{user-id}",
+							Name:   "delete-user",
+							Security: []codedom.SecuritySchemeDescriptorCollection{
+								codedom.SecuritySchemeDescriptorCollection{
+									&codedom.SecuritySchemeDescriptor{
+										Name:   "OAuth",
+										Type:   "oauth2",
+										Scopes: []string{"write:users"},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				file := generator.Generate()
+				Expect(file).NotTo(BeNil())
+
+				buffer := &bytes.Buffer{}
+				_, err := file.WriteTo(buffer)
+				Expect(err).To(BeNil())
+
+				Expect(buffer.String()).To(ContainSubstring("Authenticator Authenticator"))
+				Expect(buffer.String()).To(ContainSubstring("r.Get(\"/users\", x.GetUsers)"))
+				Expect(buffer.String()).To(ContainSubstring("authenticateOAuth(x.Authenticator, \"write:users\")"))
+				Expect(buffer.String()).To(ContainSubstring(")).Delete(\"/users/{user-id}\", x.DeleteUser)"))
+			})
+		})
+
+		Context("when the bodies are decoded", func() {
+			var manager parcello.FileSystemManager
+
+			BeforeEach(func() {
+				manager = parcello.Manager
+				parcello.Manager = parcello.Dir("../../template")
+			})
+
+			AfterEach(func() {
+				parcello.Manager = manager
+			})
+
+			It("binds only the parameters of the decoded bodies", func() {
+				generator.Controller = &codedom.ControllerDescriptor{
+					Name: "User",
+					Operations: codedom.OperationDescriptorCollection{
+						&codedom.OperationDescriptor{
+							Method: "GET",
+							Path:   "/users",
+							Name:   "get-users",
+						},
+						&codedom.OperationDescriptor{
+							Method: "PUT",
+							Path:   "/users/{user-id}/name",
+							Name:   "update-user-name",
+							Requests: codedom.RequestDescriptorCollection{
+								&codedom.RequestDescriptor{
+									ContentType: "text/plain",
+									RequestType: &codedom.TypeDescriptor{
+										Name:        "string",
+										IsPrimitive: true,
+									},
+								},
+							},
+						},
+					},
+				}
+
+				file := generator.Generate()
+				Expect(file).NotTo(BeNil())
+
+				buffer := &bytes.Buffer{}
+				_, err := file.WriteTo(buffer)
+				Expect(err).To(BeNil())
+
+				source := buffer.String()
+				Expect(strings.Count(source, "DecodeBody(r, input)")).To(Equal(1))
+				Expect(source).To(ContainSubstring("decoded, err := DecodeBody(r, input)"))
+				Expect(source).To(ContainSubstring("binder = restify.NewReactor(w, WithoutBody(r))"))
+				Expect(source).To(ContainSubstring("if err := binder.Bind(input); err != nil {"))
+				Expect(source).To(ContainSubstring("if err := reactor.Bind(input); err != nil {"))
+			})
+
+			It("validates the direction of the decoded bodies", func() {
+				generator.Validation = true
+				generator.Controller = &codedom.ControllerDescriptor{
+					Name: "User",
+					Operations: codedom.OperationDescriptorCollection{
+						&codedom.OperationDescriptor{
+							Method: "GET",
+							Path:   "/users",
+							Name:   "get-users",
+						},
+						&codedom.OperationDescriptor{
+							Method: "POST",
+							Path:   "/users",
+							Name:   "create-user",
+							Requests: codedom.RequestDescriptorCollection{
+								&codedom.RequestDescriptor{
+									ContentType: "application/json",
+									RequestType: &codedom.TypeDescriptor{
+										Name:       "user",
+										IsClass:    true,
+										IsNullable: true,
+										Properties: codedom.PropertyDescriptorCollection{
+											&codedom.PropertyDescriptor{
+												Name:     "id",
+												ReadOnly: true,
+												PropertyType: &codedom.TypeDescriptor{
+													Name:        "string",
+													IsPrimitive: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				file := generator.Generate()
+				Expect(file).NotTo(BeNil())
+
+				buffer := &bytes.Buffer{}
+				_, err := file.WriteTo(buffer)
+				Expect(err).To(BeNil())
+
+				source := buffer.String()
+				Expect(strings.Count(source, "input.ValidateRequest()")).To(Equal(1))
+				Expect(source).To(ContainSubstring("if err := input.ValidateRequest(); err != nil {"))
+			})
+		})
 	})
 
 	Context("when the mode is ControllerGeneratorModeSpec", func() {
@@ -10,25 +10,33 @@ import (
 
 // MainGenerator builds the main
 type MainGenerator struct {
-	Path     string
+	// Path to the directory of the command
+	Path string
+	// Handlers is the package that provides the server
+	Handlers *Package
 	Reporter contract.Reporter
 }
 
 // Generate generates a file
 func (g *MainGenerator) Generate() *File {
 	var (
-		path     = filepath.Join(g.Path, "cmd", filepath.Base(g.Path))
-		command  = filepath.Base(path)
-		filename = filepath.Join(path, "main.go")
+		command  = filepath.Base(g.Path)
+		filename = filepath.Join(g.Path, "main.go")
+		handlers = g.Handlers
 	)
 
+	if handlers == nil {
+		handlers = &Package{Name: defaultPackage}
+	}
+
 	reporter := g.Reporter.With(contract.SeverityHigh)
 	reporter.Notice(" Generating main file: %s...", filename)
 
 	writer := &syntax.TemplateWriter{
 		Path: "syntax/golang/main.go.tpl",
 		Context: map[string]interface{}{
-			"command": command,
+			"command":  command,
+			"handlers": handlers,
 		},
 	}
 
@@ -1,17 +1,23 @@
 package golang
 
 import (
+	"bytes"
 	"fmt"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/phogolabs/stride/codedom"
 	"github.com/phogolabs/stride/contract"
 	"github.com/phogolabs/stride/inflect"
+	"github.com/phogolabs/stride/syntax"
 )
 
 // SchemaGenerator generates a contract
 type SchemaGenerator struct {
 	Path       string
+	Package    string
+	Validation bool
 	Collection codedom.TypeDescriptorCollection
 	Reporter   contract.Reporter
 }
@@ -23,6 +29,8 @@ func (g *SchemaGenerator) Generate() *File {
 		root     = NewFile(filename)
 	)
 
+	root.SetPackage(packageOf(g.Package))
+
 	reporter := g.Reporter.With(contract.SeverityHigh)
 
 	reporter.Notice(" Generating schemas file: %s...", root.Name())
@@ -34,40 +42,97 @@ func (g *SchemaGenerator) Generate() *File {
 
 		switch {
 		case descriptor.IsAlias:
-			spec := NewLiteralType(descriptor.Name).Element(inflect.Unpointer(descriptor.Element.Kind()))
+			spec := NewLiteralType(descriptor.Identifier()).Element(inflect.Unpointer(descriptor.Element.Kind()))
 			spec.Commentf(descriptor.Description)
+			// add a import if needed
+			root.AddNamedImport(descriptor.Element.ImportName(), descriptor.Element.Namespace())
 			// add the spec the file
 			root.AddNode(spec)
 		case descriptor.IsArray:
-			spec := NewArrayType(descriptor.Name).Element(descriptor.Element.Kind())
+			spec := NewArrayType(descriptor.Identifier()).Element(descriptor.Element.Kind())
 			spec.Commentf(descriptor.Description)
+			// add a import if needed
+			root.AddNamedImport(descriptor.Element.ImportName(), descriptor.Element.Namespace())
 			// add the spec the file
 			root.AddNode(spec)
 		case descriptor.IsMap:
-			spec := NewLiteralType(descriptor.Name).Element(descriptor.Kind())
+			spec := NewLiteralType(descriptor.Identifier()).Element(descriptor.Kind())
+			spec.Commentf(descriptor.Description)
+			// add the spec the file
+			root.AddNode(spec)
+		case descriptor.IsUnion:
+			var (
+				marker = "is" + inflect.Camelize(descriptor.Identifier())
+				value  = NewInterfaceType(descriptor.Identifier() + "-value")
+			)
+
+			// the interface implemented by the variants
+			value.AddMethod(marker)
+			// add the spec the file
+			root.AddNode(value)
+
+			spec := NewStructType(descriptor.Identifier())
 			spec.Commentf(descriptor.Description)
+			spec.AddField("value", value.Name())
 			// add the spec the file
 			root.AddNode(spec)
+
+			variants := []map[string]string{}
+
+			// the properties are the variants of the union
+			for _, property := range descriptor.Properties {
+				variant := map[string]string{
+					"value": property.Name,
+					"kind":  inflect.Unpointer(property.PropertyType.Kind()),
+				}
+
+				variants = append(variants, variant)
+			}
+
+			g.function(root, "union", map[string]interface{}{
+				"receiver":      spec.Name(),
+				"function":      "union",
+				"marker":        marker,
+				"discriminator": descriptor.Metadata["discriminator"],
+				"variants":      variants,
+			})
 		case descriptor.IsClass:
-			spec := NewStructType(descriptor.Name)
+			spec := NewStructType(descriptor.Identifier())
 			spec.Commentf(descriptor.Description)
 			// add the spec the file
 			root.AddNode(spec)
 
 			// add fields
 			for _, property := range descriptor.Properties {
+				// the x-stride-omit extension excludes the field
+				if property.IsOmitted() {
+					continue
+				}
+
 				var (
 					tags = property.Tags()
-					kind = property.PropertyType.Kind()
+					kind = property.Kind()
 				)
 
+				// embed the composed types
+				if property.IsEmbedded && property.PropertyType.IsClass {
+					spec.AddEmbeddedField(property.Name, inflect.Unpointer(kind))
+					continue
+				}
+
 				// add a import if needed
-				root.AddImport(property.PropertyType.Namespace())
+				root.AddNamedImport(property.PropertyType.ImportName(), property.PropertyType.Namespace())
 				// add the field
-				spec.AddField(property.Name, kind, tags...)
+				spec.AddField(property.Identifier(), kind, tags...)
 			}
 		case descriptor.IsEnum:
-			spec := NewLiteralType(descriptor.Name).Element("string")
+			kind := "string"
+
+			if descriptor.Element != nil {
+				kind = descriptor.Element.Kind()
+			}
+
+			spec := NewLiteralType(descriptor.Identifier()).Element(kind)
 			spec.Commentf(descriptor.Description)
 			// add the spec the file
 			root.AddNode(spec)
@@ -76,17 +141,27 @@ func (g *SchemaGenerator) Generate() *File {
 			// add the spec the file
 			root.AddNode(block)
 
-			if values, ok := descriptor.Metadata["values"].([]interface{}); ok {
-				for _, item := range values {
-					var (
-						value = fmt.Sprintf("%v", item)
-						name  = inflect.Camelize(spec.Name(), value)
-					)
+			names := []string{}
 
+			if values, ok := descriptor.Metadata["values"].([]interface{}); ok {
+				for _, value := range values {
+					name := constant(spec.Name(), value)
 					block.AddConst(name, spec.Name(), value)
+					names = append(names, name)
 				}
 			}
-			continue
+
+			g.function(root, "enum", map[string]interface{}{
+				"receiver": spec.Name(),
+				"function": "enum",
+				"kind":     kind,
+				"text":     kind == "string",
+				"cases":    strings.Join(names, ", "),
+			})
+		}
+
+		if g.Validation && !descriptor.IsAlias {
+			g.validate(root, descriptor)
 		}
 
 		g.Reporter.Success("ﳑ Generation type: %s successful", inflect.Dasherize(descriptor.Name))
@@ -94,3 +169,100 @@ func (g *SchemaGenerator) Generate() *File {
 
 	return root
 }
+
+func (g *SchemaGenerator) validate(root *File, descriptor *codedom.TypeDescriptor) {
+	var (
+		receiver   = inflect.Camelize(descriptor.Identifier())
+		directed   = directional(descriptor)
+		directions = []ValidateDirection{
+			ValidateAny,
+			ValidateRequest,
+			ValidateResponse,
+		}
+	)
+
+	for _, direction := range directions {
+		ctx := map[string]interface{}{
+			"receiver":    receiver,
+			"function":    inflect.Dasherize(direction.Method()),
+			"method":      direction.Method(),
+			"directional": directed,
+		}
+
+		// the types without read-only and write-only properties are
+		// validated in the same way in every direction
+		if direction != ValidateAny && !directed {
+			ctx["delegate"] = ValidateAny.Method()
+		} else {
+			writer := NewValidateWriter()
+			writer.Direction = direction
+			writer.WriteType(descriptor)
+
+			ctx["body"] = writer.String()
+		}
+
+		g.function(root, "validate", ctx)
+	}
+}
+
+func (g *SchemaGenerator) function(root *File, name string, ctx map[string]interface{}) {
+	var (
+		receiver  = ctx["receiver"].(string)
+		operation = ctx["function"].(string)
+	)
+
+	g.Reporter.Info("ﳑ Generating type: %s function: %s...",
+		inflect.Dasherize(receiver),
+		inflect.Dasherize(operation),
+	)
+
+	writer := &syntax.TemplateWriter{
+		Path:    fmt.Sprintf("syntax/golang/%s.go.tpl", name),
+		Context: ctx,
+	}
+
+	buffer := &bytes.Buffer{}
+
+	if _, err := writer.WriteTo(buffer); err != nil {
+		g.Reporter.Error("ﳑ Generating type: %s function: %s fail: %v",
+			inflect.Dasherize(receiver),
+			inflect.Dasherize(operation),
+			err,
+		)
+
+		return
+	}
+
+	if err := root.AddFunction(buffer.String()); err != nil {
+		g.Reporter.Error("ﳑ Generating type: %s function: %s fail: %v",
+			inflect.Dasherize(receiver),
+			inflect.Dasherize(operation),
+			err,
+		)
+
+		return
+	}
+
+	g.Reporter.Success("ﳑ Generating type: %s function: %s successful",
+		inflect.Dasherize(receiver),
+		inflect.Dasherize(operation),
+	)
+}
+
+func constant(name string, value interface{}) string {
+	text := fmt.Sprintf("%v", value)
+
+	switch item := value.(type) {
+	case float32:
+		text = strconv.FormatFloat(float64(item), 'f', -1, 64)
+	case float64:
+		text = strconv.FormatFloat(item, 'f', -1, 64)
+	}
+
+	if _, err := strconv.ParseFloat(text, 64); err == nil {
+		// the sign and the decimal point are not valid in the identifiers
+		text = strings.NewReplacer("-", "minus-", ".", "-dot-").Replace(text)
+	}
+
+	return inflect.Camelize(name, text)
+}
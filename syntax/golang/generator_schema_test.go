@@ -188,6 +188,251 @@ var _ = Describe("SchemaGenerator", func() {
 		})
 	})
 
+	Context("when the descriptor is class with mapped types", func() {
+		BeforeEach(func() {
+			descriptor := &codedom.TypeDescriptor{
+				Name:    "Payment",
+				IsClass: true,
+				Properties: codedom.PropertyDescriptorCollection{
+					&codedom.PropertyDescriptor{
+						Name: "Fee",
+						PropertyType: &codedom.TypeDescriptor{
+							Name:        "string",
+							IsPrimitive: true,
+							Metadata: codedom.Metadata{
+								"go_type":        "dec.Decimal",
+								"go_import":      "github.com/shopspring/decimal",
+								"go_import_name": "dec",
+							},
+						},
+					},
+					&codedom.PropertyDescriptor{
+						Name: "ID",
+						PropertyType: &codedom.TypeDescriptor{
+							Name:        "uuid",
+							IsPrimitive: true,
+							Metadata: codedom.Metadata{
+								"go_type": "github.com/google/uuid.UUID",
+							},
+						},
+					},
+				},
+			}
+
+			generator.Collection = append(generator.Collection, descriptor)
+		})
+
+		It("imports the packages of the mapped types", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+
+			buffer := &bytes.Buffer{}
+			_, err := file.WriteTo(buffer)
+			Expect(err).To(BeNil())
+
+			source := buffer.String()
+			Expect(source).To(ContainSubstring(`dec "github.com/shopspring/decimal"`))
+			Expect(source).To(ContainSubstring(`"github.com/google/uuid"`))
+			Expect(source).To(ContainSubstring("\tFee dec.Decimal `"))
+			Expect(source).To(ContainSubstring("\tID uuid.UUID `"))
+		})
+	})
+
+	Context("when the descriptor is class with naming extensions", func() {
+		BeforeEach(func() {
+			descriptor := &codedom.TypeDescriptor{
+				Name:    "api-key",
+				IsClass: true,
+				Metadata: codedom.Metadata{
+					"go_name": "APIKey",
+				},
+				Properties: codedom.PropertyDescriptorCollection{
+					&codedom.PropertyDescriptor{
+						Name:     "id",
+						Required: true,
+						PropertyType: &codedom.TypeDescriptor{
+							Name:        "string",
+							IsPrimitive: true,
+						},
+						Metadata: codedom.Metadata{
+							"go_name": "KeyID",
+						},
+					},
+					&codedom.PropertyDescriptor{
+						Name: "name",
+						PropertyType: &codedom.TypeDescriptor{
+							Name:        "string",
+							IsPrimitive: true,
+						},
+						Metadata: codedom.Metadata{
+							"go_pointer": true,
+						},
+					},
+					&codedom.PropertyDescriptor{
+						Name: "secret",
+						PropertyType: &codedom.TypeDescriptor{
+							Name:        "string",
+							IsPrimitive: true,
+						},
+						Metadata: codedom.Metadata{
+							"go_omit": true,
+						},
+					},
+				},
+			}
+
+			generator.Collection = append(generator.Collection, descriptor)
+		})
+
+		It("generates the schema successfully", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+
+			buffer := &bytes.Buffer{}
+			_, err := file.WriteTo(buffer)
+			Expect(err).To(BeNil())
+
+			source := buffer.String()
+			Expect(source).To(ContainSubstring("type APIKey struct"))
+			Expect(source).To(ContainSubstring("\tKeyID string `json:\"id\""))
+			Expect(source).To(ContainSubstring("\tName *string `json:\"name,omitempty\""))
+			Expect(source).NotTo(ContainSubstring("Secret"))
+		})
+	})
+
+	Context("when the descriptor is class with embedded types", func() {
+		BeforeEach(func() {
+			descriptor := &codedom.TypeDescriptor{
+				Name:    "User",
+				IsClass: true,
+				Properties: codedom.PropertyDescriptorCollection{
+					&codedom.PropertyDescriptor{
+						Name:       "base-entity",
+						Required:   true,
+						IsEmbedded: true,
+						PropertyType: &codedom.TypeDescriptor{
+							Name:       "base-entity",
+							IsClass:    true,
+							IsNullable: true,
+						},
+					},
+					&codedom.PropertyDescriptor{
+						Name: "name",
+						PropertyType: &codedom.TypeDescriptor{
+							Name:        "string",
+							IsPrimitive: true,
+						},
+					},
+				},
+			}
+
+			generator.Collection = append(generator.Collection, descriptor)
+		})
+
+		It("generates the schema successfully", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+
+			buffer := &bytes.Buffer{}
+			_, err := file.WriteTo(buffer)
+			Expect(err).To(BeNil())
+
+			var (
+				scanner = bufio.NewScanner(buffer)
+				line    = 0
+			)
+
+			for scanner.Scan() {
+				text := scanner.Text()
+
+				switch line {
+				case 4:
+					Expect(text).To(Equal("type User struct {"))
+				case 5:
+					Expect(text).To(Equal("\t// stride:generate base-entity"))
+				case 6:
+					Expect(text).To(Equal("\tBaseEntity"))
+				case 7:
+					Expect(text).To(Equal("\t// stride:generate name"))
+				case 8:
+					Expect(text).To(HavePrefix("\tName string `json:\"name,omitempty\""))
+				case 9:
+					Expect(text).To(Equal("}"))
+				}
+
+				line = line + 1
+			}
+		})
+	})
+
+	Context("when the descriptor is union", func() {
+		BeforeEach(func() {
+			descriptor := &codedom.TypeDescriptor{
+				Name:       "Payment",
+				IsUnion:    true,
+				IsNullable: true,
+				Metadata: codedom.Metadata{
+					"discriminator": "payment_type",
+				},
+				Properties: codedom.PropertyDescriptorCollection{
+					&codedom.PropertyDescriptor{
+						Name: "card",
+						PropertyType: &codedom.TypeDescriptor{
+							Name:       "card-payment",
+							IsClass:    true,
+							IsNullable: true,
+						},
+					},
+				},
+			}
+
+			generator.Collection = append(generator.Collection, descriptor)
+		})
+
+		It("generates the schema successfully", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+
+			buffer := &bytes.Buffer{}
+			_, err := file.WriteTo(buffer)
+			Expect(err).To(BeNil())
+
+			var (
+				scanner = bufio.NewScanner(buffer)
+				line    = 0
+			)
+
+			for scanner.Scan() {
+				text := scanner.Text()
+
+				switch line {
+				case 2:
+					Expect(text).To(Equal("// PaymentValue is a type auto-generated from OpenAPI spec"))
+				case 3:
+					Expect(text).To(Equal("// stride:generate payment-value"))
+				case 4:
+					Expect(text).To(Equal("type PaymentValue interface {"))
+				case 5:
+					Expect(text).To(Equal("\tisPayment()"))
+				case 6:
+					Expect(text).To(Equal("}"))
+				case 8:
+					Expect(text).To(Equal("// Payment is a type auto-generated from OpenAPI spec"))
+				case 10:
+					Expect(text).To(Equal("type Payment struct {"))
+				case 11:
+					Expect(text).To(Equal("\t// stride:generate value"))
+				case 12:
+					Expect(text).To(Equal("\tValue PaymentValue"))
+				case 13:
+					Expect(text).To(Equal("}"))
+				}
+
+				line = line + 1
+			}
+		})
+	})
+
 	Context("when the descriptor is enum", func() {
 		BeforeEach(func() {
 			descriptor := &codedom.TypeDescriptor{
@@ -241,4 +486,100 @@ var _ = Describe("SchemaGenerator", func() {
 			}
 		})
 	})
+
+	Context("when the descriptor is integer enum", func() {
+		BeforeEach(func() {
+			descriptor := &codedom.TypeDescriptor{
+				Name:   "Priority",
+				IsEnum: true,
+				Element: &codedom.TypeDescriptor{
+					Name:        "int32",
+					IsPrimitive: true,
+				},
+				Metadata: codedom.Metadata{
+					"values": []interface{}{-1.0, 1.0},
+				},
+			}
+
+			generator.Collection = append(generator.Collection, descriptor)
+		})
+
+		It("generates the schema successfully", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+
+			buffer := &bytes.Buffer{}
+			_, err := file.WriteTo(buffer)
+			Expect(err).To(BeNil())
+
+			var (
+				scanner = bufio.NewScanner(buffer)
+				line    = 0
+			)
+
+			for scanner.Scan() {
+				text := strings.TrimSpace(scanner.Text())
+
+				switch line {
+				case 4:
+					Expect(text).To(Equal("type Priority int32"))
+				case 7:
+					Expect(text).To(Equal("// PriorityMinus1 is a \"-1\" constant auto-generated from OpenAPI spec"))
+				case 8:
+					Expect(text).To(Equal("// stride:generate priority-minus1"))
+				case 9:
+					Expect(text).To(Equal("PriorityMinus1 Priority = -1"))
+				case 13:
+					Expect(text).To(Equal("Priority1 Priority = 1"))
+				}
+
+				line = line + 1
+			}
+		})
+	})
+
+	Context("when the descriptor is number enum", func() {
+		BeforeEach(func() {
+			descriptor := &codedom.TypeDescriptor{
+				Name:   "Ratio",
+				IsEnum: true,
+				Element: &codedom.TypeDescriptor{
+					Name:        "float64",
+					IsPrimitive: true,
+				},
+				Metadata: codedom.Metadata{
+					"values": []interface{}{0.5},
+				},
+			}
+
+			generator.Collection = append(generator.Collection, descriptor)
+		})
+
+		It("generates the schema successfully", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+
+			buffer := &bytes.Buffer{}
+			_, err := file.WriteTo(buffer)
+			Expect(err).To(BeNil())
+
+			var (
+				scanner = bufio.NewScanner(buffer)
+				line    = 0
+			)
+
+			for scanner.Scan() {
+				text := strings.TrimSpace(scanner.Text())
+
+				switch line {
+				case 4:
+					Expect(text).To(Equal("type Ratio float64"))
+				case 9:
+					Expect(text).To(Equal("Ratio0Dot5 Ratio = 0.5"))
+				}
+
+				line = line + 1
+			}
+		})
+	})
 })
@@ -0,0 +1,471 @@
+package golang
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/inflect"
+)
+
+// ValidateDirection is the direction in which a value is validated
+type ValidateDirection int
+
+const (
+	// ValidateAny validates a value regardless of its direction
+	ValidateAny ValidateDirection = iota
+	// ValidateRequest validates a value sent by the client. The read-only
+	// properties must not be set.
+	ValidateRequest
+	// ValidateResponse validates a value sent by the server. The write-only
+	// properties must not be set.
+	ValidateResponse
+)
+
+// Method returns the name of the validation method of the direction
+func (d ValidateDirection) Method() string {
+	switch d {
+	case ValidateRequest:
+		return "ValidateRequest"
+	case ValidateResponse:
+		return "ValidateResponse"
+	default:
+		return "Validate"
+	}
+}
+
+// directional returns true if the validation of the type depends on the
+// direction, because it has read-only or write-only properties
+func directional(descriptor *codedom.TypeDescriptor) bool {
+	return directionalOf(descriptor, map[*codedom.TypeDescriptor]bool{})
+}
+
+func directionalOf(descriptor *codedom.TypeDescriptor, visited map[*codedom.TypeDescriptor]bool) bool {
+	// the recursive types are checked once
+	if descriptor == nil || visited[descriptor] {
+		return false
+	}
+
+	visited[descriptor] = true
+
+	for _, property := range descriptor.Properties {
+		if property.IsOmitted() {
+			continue
+		}
+
+		if property.ReadOnly || property.WriteOnly {
+			return true
+		}
+
+		if directionalOf(property.PropertyType, visited) {
+			return true
+		}
+	}
+
+	return directionalOf(descriptor.Key, visited) || directionalOf(descriptor.Element, visited)
+}
+
+// validateBodies returns the rules of the bodies of an input or an output. The
+// rules are empty if the bodies have nothing to validate.
+func validateBodies(direction ValidateDirection, fields map[string]*codedom.TypeDescriptor) string {
+	names := []string{}
+
+	for name := range fields {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	writer := NewValidateWriter()
+	writer.Direction = direction
+
+	for _, name := range names {
+		writer.WriteValue(`""`, "x."+name, fields[name], false)
+	}
+
+	return writer.String()
+}
+
+// fieldsOf returns the types of the body fields of an input
+func fieldsOf(bodies []*body) map[string]*codedom.TypeDescriptor {
+	fields := map[string]*codedom.TypeDescriptor{}
+
+	for _, body := range bodies {
+		fields[body.Field] = body.Request.RequestType
+	}
+
+	return fields
+}
+
+// isValidated returns true if the input of the requests has the generated
+// ValidateRequest method
+func isValidated(requests codedom.RequestDescriptorCollection) bool {
+	bodies, _ := bodiesOf(requests)
+	return validateBodies(ValidateRequest, fieldsOf(bodies)) != ""
+}
+
+// isValidatedOutput returns true if the output with the given body has the
+// generated ValidateResponse method
+func isValidatedOutput(kind *codedom.TypeDescriptor) bool {
+	if kind == nil {
+		return false
+	}
+
+	return validateBodies(ValidateResponse, map[string]*codedom.TypeDescriptor{"Body": kind}) != ""
+}
+
+// ValidateWriter writes the body of the Validate method of a type
+type ValidateWriter struct {
+	// Direction is the direction in which the values are validated
+	Direction ValidateDirection
+	buffer    *bytes.Buffer
+	depth     int
+}
+
+// NewValidateWriter creates a new ValidateWriter
+func NewValidateWriter() *ValidateWriter {
+	return &ValidateWriter{
+		buffer: &bytes.Buffer{},
+	}
+}
+
+// String returns the body
+func (w *ValidateWriter) String() string {
+	return w.buffer.String()
+}
+
+// WriteType writes the rules of a declared type
+func (w *ValidateWriter) WriteType(descriptor *codedom.TypeDescriptor) {
+	switch {
+	case descriptor.IsUnion:
+		w.printf("if value, ok := x.Value.(interface{ %s() error }); ok {", w.Direction.Method())
+		w.printf("errs.Merge(\"\", value.%s())", w.Direction.Method())
+		w.printf("}")
+	case descriptor.IsEnum:
+		var (
+			name   = inflect.Camelize(descriptor.Identifier())
+			values = []string{}
+		)
+
+		if items, ok := descriptor.Metadata["values"].([]interface{}); ok {
+			for _, item := range items {
+				values = append(values, constant(name, item))
+			}
+		}
+
+		if len(values) == 0 {
+			return
+		}
+
+		w.printf("switch x {")
+		w.printf("case %s:", strings.Join(values, ", "))
+		w.printf("default:")
+		w.printf("errs.Add(\"\", \"must be one of %%s\", %q)", w.oneof(descriptor))
+		w.printf("}")
+	case descriptor.IsClass:
+		for _, property := range descriptor.Properties {
+			if property.IsOmitted() {
+				continue
+			}
+
+			var (
+				path  = fmt.Sprintf("%q", property.Name)
+				value = "x." + inflect.Camelize(property.Identifier())
+			)
+
+			if property.IsEmbedded {
+				path = `""`
+			}
+
+			if property.IsEmbedded && property.PropertyType.IsClass {
+				w.printf("errs.Merge(%s, %s.%s())", path, value, w.Direction.Method())
+				continue
+			}
+
+			switch {
+			case property.ReadOnly && w.Direction == ValidateRequest:
+				w.absent(path, value, property.Kind(), property.PropertyType, "is read-only")
+				continue
+			case property.WriteOnly && w.Direction == ValidateResponse:
+				w.absent(path, value, property.Kind(), property.PropertyType, "is write-only")
+				continue
+			}
+
+			required := property.Required
+
+			// the read-only and write-only properties are present in one direction only
+			if w.Direction == ValidateAny {
+				required = required && !property.ReadOnly && !property.WriteOnly
+			}
+
+			w.value(path, value, property.Kind(), property.PropertyType, required)
+		}
+	case descriptor.IsArray:
+		w.collection("x", `""`, descriptor)
+	case descriptor.IsMap:
+		w.collection("x", `""`, descriptor)
+	}
+}
+
+// WriteValue writes the rules of a property value
+func (w *ValidateWriter) WriteValue(path, value string, descriptor *codedom.TypeDescriptor, required bool) {
+	w.value(path, value, descriptor.Kind(), descriptor, required)
+}
+
+// value writes the rules of a value of the given golang kind. The kind of a
+// field may differ from the kind of its type.
+func (w *ValidateWriter) value(path, value, kind string, descriptor *codedom.TypeDescriptor, required bool) {
+	if strings.HasPrefix(kind, "*") {
+		if required {
+			w.printf("if %s == nil {", value)
+			w.printf("errs.Add(%s, \"is required\")", path)
+			w.printf("}")
+		}
+
+		w.pointer(path, value, descriptor)
+		return
+	}
+
+	var (
+		zero  = w.zero(descriptor)
+		rules = w.child(func(child *ValidateWriter) {
+			child.rules(path, value, descriptor)
+		})
+	)
+
+	switch {
+	case zero == "":
+		w.buffer.WriteString(rules)
+	case required && zero != "0":
+		// zero is a valid number
+		w.printf("if %s == %s {", value, zero)
+		w.printf("errs.Add(%s, \"is required\")", path)
+
+		if rules != "" {
+			w.printf("} else {")
+			w.buffer.WriteString(rules)
+		}
+
+		w.printf("}")
+	case required:
+		w.buffer.WriteString(rules)
+	case rules != "":
+		// the zero value of an optional property is omitted
+		w.printf("if %s != %s {", value, zero)
+		w.buffer.WriteString(rules)
+		w.printf("}")
+	}
+}
+
+// absent writes the rule of a value that must not be set in the direction
+func (w *ValidateWriter) absent(path, value, kind string, descriptor *codedom.TypeDescriptor, message string) {
+	zero := "nil"
+
+	if !strings.HasPrefix(kind, "*") {
+		zero = w.zero(descriptor)
+	}
+
+	// the zero value of a struct cannot be compared
+	if zero == "" {
+		return
+	}
+
+	w.printf("if %s != %s {", value, zero)
+	w.printf("errs.Add(%s, %q)", path, message)
+	w.printf("}")
+}
+
+func (w *ValidateWriter) element(path, value string, descriptor *codedom.TypeDescriptor) {
+	if strings.HasPrefix(descriptor.Kind(), "*") {
+		w.pointer(path, value, descriptor)
+		return
+	}
+
+	w.rules(path, value, descriptor)
+}
+
+func (w *ValidateWriter) pointer(path, value string, descriptor *codedom.TypeDescriptor) {
+	rules := w.child(func(child *ValidateWriter) {
+		child.rules(path, fmt.Sprintf("(*%s)", value), descriptor)
+	})
+
+	if rules != "" {
+		w.printf("if %s != nil {", value)
+		w.buffer.WriteString(rules)
+		w.printf("}")
+	}
+}
+
+func (w *ValidateWriter) rules(path, value string, descriptor *codedom.TypeDescriptor) {
+	switch {
+	case descriptor.IsClass, descriptor.IsArray, descriptor.IsEnum, descriptor.IsUnion:
+		w.printf("errs.Merge(%s, %s.%s())", path, value, w.Direction.Method())
+	case descriptor.IsMap:
+		w.collection(value, path, descriptor)
+	case descriptor.IsPrimitive:
+		w.primitive(path, value, descriptor)
+	}
+}
+
+func (w *ValidateWriter) zero(descriptor *codedom.TypeDescriptor) string {
+	switch {
+	case descriptor.IsArray, descriptor.IsMap:
+		return "nil"
+	case descriptor.IsEnum:
+		if descriptor.Element != nil {
+			return w.zero(descriptor.Element)
+		}
+
+		return `""`
+	case descriptor.IsPrimitive:
+		switch descriptor.Name {
+		case "string":
+			return `""`
+		case "int32", "int64", "float32", "float64":
+			return "0"
+		}
+	}
+
+	return ""
+}
+
+func (w *ValidateWriter) collection(value, path string, descriptor *codedom.TypeDescriptor) {
+	var (
+		metadata = descriptor.Metadata
+		index    = fmt.Sprintf("index%d", w.depth)
+		item     = fmt.Sprintf("item%d", w.depth)
+	)
+
+	if min := w.number(metadata, "min"); min != "" && min != "0" {
+		w.printf("if len(%s) < %s {", value, min)
+		w.printf("errs.Add(%s, \"must have at least %s items\")", path, min)
+		w.printf("}")
+	}
+
+	if max := w.number(metadata, "max"); max != "" {
+		w.printf("if len(%s) > %s {", value, max)
+		w.printf("errs.Add(%s, \"must have at most %s items\")", path, max)
+		w.printf("}")
+	}
+
+	if unique, ok := metadata["unique"].(bool); ok && unique {
+		w.printf("{")
+		w.printf("keys := map[string]bool{}")
+		w.printf("for %s, %s := range %s {", index, item, value)
+		w.printf("key := uniqueKey(%s)", item)
+		w.printf("if keys[key] {")
+		w.printf("errs.Add(fieldIndex(%s, %s), \"must be unique\")", path, index)
+		w.printf("}")
+		w.printf("keys[key] = true")
+		w.printf("}")
+		w.printf("}")
+	}
+
+	rules := w.child(func(child *ValidateWriter) {
+		child.element(fmt.Sprintf("fieldIndex(%s, %s)", path, index), item, descriptor.Element)
+	})
+
+	if rules != "" {
+		w.printf("for %s, %s := range %s {", index, item, value)
+		w.buffer.WriteString(rules)
+		w.printf("}")
+	}
+}
+
+func (w *ValidateWriter) primitive(path, value string, descriptor *codedom.TypeDescriptor) {
+	metadata := descriptor.Metadata
+
+	switch descriptor.Name {
+	case "string":
+		if min := w.number(metadata, "min"); min != "" && min != "0" {
+			w.printf("if utf8.RuneCountInString(%s) < %s {", value, min)
+			w.printf("errs.Add(%s, \"must have at least %s characters\")", path, min)
+			w.printf("}")
+		}
+
+		if max := w.number(metadata, "max"); max != "" {
+			w.printf("if utf8.RuneCountInString(%s) > %s {", value, max)
+			w.printf("errs.Add(%s, \"must have at most %s characters\")", path, max)
+			w.printf("}")
+		}
+
+		if metadata.HasPattern() {
+			pattern := fmt.Sprintf("%q", metadata["pattern"])
+
+			w.printf("if !matchPattern(%s, %s) {", pattern, value)
+			w.printf("errs.Add(%s, \"must match the pattern %%s\", %s)", path, pattern)
+			w.printf("}")
+		}
+	case "int32", "int64", "float32", "float64":
+		exclusive := func(key string) bool {
+			value, _ := metadata[key].(bool)
+			return value
+		}
+
+		if min := w.number(metadata, "min"); min != "" {
+			if exclusive("min_exclusive") {
+				w.printf("if float64(%s) <= %s {", value, min)
+				w.printf("errs.Add(%s, \"must be greater than %s\")", path, min)
+			} else {
+				w.printf("if float64(%s) < %s {", value, min)
+				w.printf("errs.Add(%s, \"must be greater than or equal to %s\")", path, min)
+			}
+			w.printf("}")
+		}
+
+		if max := w.number(metadata, "max"); max != "" {
+			if exclusive("max_exclusive") {
+				w.printf("if float64(%s) >= %s {", value, max)
+				w.printf("errs.Add(%s, \"must be less than %s\")", path, max)
+			} else {
+				w.printf("if float64(%s) > %s {", value, max)
+				w.printf("errs.Add(%s, \"must be less than or equal to %s\")", path, max)
+			}
+			w.printf("}")
+		}
+
+		if factor := w.number(metadata, "multiple_of"); factor != "" {
+			w.printf("if !multipleOf(float64(%s), %s) {", value, factor)
+			w.printf("errs.Add(%s, \"must be a multiple of %s\")", path, factor)
+			w.printf("}")
+		}
+	}
+}
+
+func (w *ValidateWriter) child(fn func(child *ValidateWriter)) string {
+	child := &ValidateWriter{
+		Direction: w.Direction,
+		buffer:    &bytes.Buffer{},
+		depth:     w.depth + 1,
+	}
+
+	fn(child)
+	return child.String()
+}
+
+func (w *ValidateWriter) number(metadata codedom.Metadata, key string) string {
+	if value, ok := metadata[key].(*float64); ok && value != nil {
+		return fmt.Sprintf("%v", *value)
+	}
+
+	return ""
+}
+
+func (w *ValidateWriter) oneof(descriptor *codedom.TypeDescriptor) string {
+	values := []string{}
+
+	if items, ok := descriptor.Metadata["values"].([]interface{}); ok {
+		for _, item := range items {
+			values = append(values, fmt.Sprintf("%v", item))
+		}
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func (w *ValidateWriter) printf(format string, args ...interface{}) {
+	fmt.Fprintf(w.buffer, format, args...)
+	fmt.Fprintln(w.buffer)
+}
@@ -0,0 +1,297 @@
+package golang_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/syntax/golang"
+)
+
+var _ = Describe("ValidateWriter", func() {
+	var writer *golang.ValidateWriter
+
+	float64Ptr := func(v float64) *float64 {
+		return &v
+	}
+
+	BeforeEach(func() {
+		writer = golang.NewValidateWriter()
+	})
+
+	Context("when the descriptor is class", func() {
+		It("writes the property rules", func() {
+			descriptor := &codedom.TypeDescriptor{
+				Name:    "user",
+				IsClass: true,
+				Properties: codedom.PropertyDescriptorCollection{
+					&codedom.PropertyDescriptor{
+						Name:     "name",
+						Required: true,
+						PropertyType: &codedom.TypeDescriptor{
+							Name:        "string",
+							IsPrimitive: true,
+							Metadata: codedom.Metadata{
+								"max":     float64Ptr(10),
+								"pattern": "^[a-z]+$",
+							},
+						},
+					},
+					&codedom.PropertyDescriptor{
+						Name: "age",
+						PropertyType: &codedom.TypeDescriptor{
+							Name:        "int32",
+							IsPrimitive: true,
+							Metadata: codedom.Metadata{
+								"min":           float64Ptr(18),
+								"min_exclusive": true,
+							},
+						},
+					},
+				},
+			}
+
+			writer.WriteType(descriptor)
+
+			Expect(writer.String()).To(Equal(`if x.Name == "" {
+errs.Add("name", "is required")
+} else {
+if utf8.RuneCountInString(x.Name) > 10 {
+errs.Add("name", "must have at most 10 characters")
+}
+if !matchPattern("^[a-z]+$", x.Name) {
+errs.Add("name", "must match the pattern %s", "^[a-z]+$")
+}
+}
+if x.Age != 0 {
+if float64(x.Age) <= 18 {
+errs.Add("age", "must be greater than 18")
+}
+}
+`))
+		})
+
+		Context("when the property is nullable", func() {
+			It("writes the property rules", func() {
+				descriptor := &codedom.TypeDescriptor{
+					Name:    "user",
+					IsClass: true,
+					Properties: codedom.PropertyDescriptorCollection{
+						&codedom.PropertyDescriptor{
+							Name:     "address",
+							Required: true,
+							PropertyType: &codedom.TypeDescriptor{
+								Name:       "address",
+								IsClass:    true,
+								IsNullable: true,
+							},
+						},
+					},
+				}
+
+				writer.WriteType(descriptor)
+
+				Expect(writer.String()).To(Equal(`if x.Address == nil {
+errs.Add("address", "is required")
+}
+if x.Address != nil {
+errs.Merge("address", (*x.Address).Validate())
+}
+`))
+			})
+		})
+		Context("when the property has naming extensions", func() {
+			It("writes the property rules", func() {
+				descriptor := &codedom.TypeDescriptor{
+					Name:    "api-key",
+					IsClass: true,
+					Properties: codedom.PropertyDescriptorCollection{
+						&codedom.PropertyDescriptor{
+							Name:     "id",
+							Required: true,
+							PropertyType: &codedom.TypeDescriptor{
+								Name:        "string",
+								IsPrimitive: true,
+							},
+							Metadata: codedom.Metadata{
+								"go_name":    "KeyID",
+								"go_pointer": true,
+							},
+						},
+						&codedom.PropertyDescriptor{
+							Name:     "secret",
+							Required: true,
+							PropertyType: &codedom.TypeDescriptor{
+								Name:        "string",
+								IsPrimitive: true,
+							},
+							Metadata: codedom.Metadata{
+								"go_omit": true,
+							},
+						},
+					},
+				}
+
+				writer.WriteType(descriptor)
+
+				Expect(writer.String()).To(Equal(`if x.KeyID == nil {
+errs.Add("id", "is required")
+}
+`))
+			})
+		})
+	})
+
+	Context("when the properties are read-only and write-only", func() {
+		var descriptor *codedom.TypeDescriptor
+
+		BeforeEach(func() {
+			descriptor = &codedom.TypeDescriptor{
+				Name:    "credential",
+				IsClass: true,
+				Properties: codedom.PropertyDescriptorCollection{
+					&codedom.PropertyDescriptor{
+						Name:     "id",
+						Required: true,
+						ReadOnly: true,
+						PropertyType: &codedom.TypeDescriptor{
+							Name:        "string",
+							IsPrimitive: true,
+						},
+					},
+					&codedom.PropertyDescriptor{
+						Name:      "secret",
+						Required:  true,
+						WriteOnly: true,
+						PropertyType: &codedom.TypeDescriptor{
+							Name:        "string",
+							IsPrimitive: true,
+						},
+					},
+					&codedom.PropertyDescriptor{
+						Name: "owner",
+						PropertyType: &codedom.TypeDescriptor{
+							Name:       "user",
+							IsClass:    true,
+							IsNullable: true,
+						},
+					},
+				},
+			}
+		})
+
+		It("does not require them in any direction", func() {
+			writer.WriteType(descriptor)
+
+			Expect(writer.String()).To(Equal(`if x.Owner != nil {
+errs.Merge("owner", (*x.Owner).Validate())
+}
+`))
+		})
+
+		Context("when the direction is request", func() {
+			BeforeEach(func() {
+				writer.Direction = golang.ValidateRequest
+			})
+
+			It("rejects the read-only properties", func() {
+				writer.WriteType(descriptor)
+
+				Expect(writer.String()).To(Equal(`if x.ID != "" {
+errs.Add("id", "is read-only")
+}
+if x.Secret == "" {
+errs.Add("secret", "is required")
+}
+if x.Owner != nil {
+errs.Merge("owner", (*x.Owner).ValidateRequest())
+}
+`))
+			})
+		})
+
+		Context("when the direction is response", func() {
+			BeforeEach(func() {
+				writer.Direction = golang.ValidateResponse
+			})
+
+			It("rejects the write-only properties", func() {
+				writer.WriteType(descriptor)
+
+				Expect(writer.String()).To(Equal(`if x.ID == "" {
+errs.Add("id", "is required")
+}
+if x.Secret != "" {
+errs.Add("secret", "is write-only")
+}
+if x.Owner != nil {
+errs.Merge("owner", (*x.Owner).ValidateResponse())
+}
+`))
+			})
+		})
+	})
+
+	Context("when the descriptor is array", func() {
+		It("writes the item rules", func() {
+			descriptor := &codedom.TypeDescriptor{
+				Name:    "tags",
+				IsArray: true,
+				Element: &codedom.TypeDescriptor{
+					Name:        "float64",
+					IsPrimitive: true,
+					Metadata: codedom.Metadata{
+						"multiple_of": float64Ptr(0.5),
+					},
+				},
+				Metadata: codedom.Metadata{
+					"unique": true,
+					"max":    float64Ptr(3),
+				},
+			}
+
+			writer.WriteType(descriptor)
+
+			Expect(writer.String()).To(Equal(`if len(x) > 3 {
+errs.Add("", "must have at most 3 items")
+}
+{
+keys := map[string]bool{}
+for index0, item0 := range x {
+key := uniqueKey(item0)
+if keys[key] {
+errs.Add(fieldIndex("", index0), "must be unique")
+}
+keys[key] = true
+}
+}
+for index0, item0 := range x {
+if !multipleOf(float64(item0), 0.5) {
+errs.Add(fieldIndex("", index0), "must be a multiple of 0.5")
+}
+}
+`))
+		})
+	})
+
+	Context("when the descriptor is enum", func() {
+		It("writes the value rules", func() {
+			descriptor := &codedom.TypeDescriptor{
+				Name:   "status",
+				IsEnum: true,
+				Metadata: codedom.Metadata{
+					"values": []interface{}{"pending", "completed"},
+				},
+			}
+
+			writer.WriteType(descriptor)
+
+			Expect(writer.String()).To(Equal(`switch x {
+case StatusPending, StatusCompleted:
+default:
+errs.Add("", "must be one of %s", "pending, completed")
+}
+`))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package golang
+
+import (
+	"bytes"
+	"path/filepath"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/syntax"
+)
+
+// SecurityGenerator builds the authenticators and the middleware of the
+// security schemes
+type SecurityGenerator struct {
+	Path     string
+	Package  string
+	Schemes  codedom.SecuritySchemeDescriptorCollection
+	Reporter contract.Reporter
+}
+
+// Generate generates a file
+func (g *SecurityGenerator) Generate() *File {
+	filename := filepath.Join(g.Path, "security.go")
+
+	if len(g.Schemes) == 0 {
+		return nil
+	}
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+	reporter.Notice(" Generating security file: %s...", filename)
+
+	writer := &syntax.TemplateWriter{
+		Path: "syntax/golang/security.go.tpl",
+		Context: map[string]interface{}{
+			"package": packageOf(g.Package),
+			"schemes": g.Schemes,
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	if _, err := writer.WriteTo(buffer); err != nil {
+		reporter.Error(" Generating security file: %s fail: %v", filename, err)
+		return nil
+	}
+
+	root, err := ReadFile(filename, buffer)
+	if err != nil {
+		reporter.Error(" Generating security file: %s fail: %v", filename, err)
+		return nil
+	}
+
+	reporter.Notice(" Generating security file: %s successful", filename)
+	return root
+}
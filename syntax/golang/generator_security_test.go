@@ -0,0 +1,90 @@
+package golang_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/parcello"
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/syntax/golang"
+)
+
+var _ = Describe("SecurityGenerator", func() {
+	var generator *golang.SecurityGenerator
+
+	BeforeEach(func() {
+		reporter := &fake.Reporter{}
+		reporter.WithReturns(reporter)
+
+		generator = &golang.SecurityGenerator{
+			Path:     tmpdir(),
+			Reporter: reporter,
+		}
+	})
+
+	Context("when there are no security schemes", func() {
+		It("does not generate the file", func() {
+			Expect(generator.Generate()).To(BeNil())
+
+			reporter := generator.Reporter.(*fake.Reporter)
+			Expect(reporter.NoticeCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when there are security schemes", func() {
+		var manager parcello.FileSystemManager
+
+		BeforeEach(func() {
+			manager = parcello.Manager
+			parcello.Manager = parcello.Dir("../../template")
+
+			generator.Schemes = codedom.SecuritySchemeDescriptorCollection{
+				&codedom.SecuritySchemeDescriptor{
+					Name: "ApiKey",
+					Type: "apiKey",
+					In:   "query",
+					Key:  "api_key",
+				},
+				&codedom.SecuritySchemeDescriptor{
+					Name:   "BasicAuth",
+					Type:   "http",
+					Scheme: "basic",
+				},
+				&codedom.SecuritySchemeDescriptor{
+					Name:   "BearerAuth",
+					Type:   "http",
+					Scheme: "bearer",
+				},
+				&codedom.SecuritySchemeDescriptor{
+					Name: "PetStoreAuth",
+					Type: "oauth2",
+				},
+			}
+		})
+
+		AfterEach(func() {
+			parcello.Manager = manager
+		})
+
+		It("generates an authenticator per scheme", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+
+			buffer := &bytes.Buffer{}
+			_, err := file.WriteTo(buffer)
+			Expect(err).To(BeNil())
+
+			source := buffer.String()
+			Expect(source).To(ContainSubstring("ApiKeyAuthenticator\n"))
+			Expect(source).To(ContainSubstring("AuthenticateApiKey(ctx context.Context, key string) (context.Context, error)"))
+			Expect(source).To(ContainSubstring("AuthenticateBasicAuth(ctx context.Context, username, password string) (context.Context, error)"))
+			Expect(source).To(ContainSubstring("AuthenticateBearerAuth(ctx context.Context, token string) (context.Context, error)"))
+			Expect(source).To(ContainSubstring("AuthenticatePetStoreAuth(ctx context.Context, token string, scopes []string) (context.Context, error)"))
+			Expect(source).To(ContainSubstring("r.URL.Query().Get(\"api_key\")"))
+			Expect(source).To(ContainSubstring("func authenticate(requirements ...[]authenticateFunc) func(http.Handler) http.Handler"))
+		})
+	})
+})
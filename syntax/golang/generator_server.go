@@ -11,7 +11,12 @@ import (
 
 // ServerGenerator builds a server
 type ServerGenerator struct {
-	Path        string
+	Path    string
+	Package string
+	// Models is the package of the models if they are not in the same package
+	Models *Package
+	// Validations is true if the models have the custom validations
+	Validations bool
 	Controllers codedom.ControllerDescriptorCollection
 	Reporter    contract.Reporter
 }
@@ -26,7 +31,11 @@ func (g *ServerGenerator) Generate() *File {
 	writer := &syntax.TemplateWriter{
 		Path: "syntax/golang/server.go.tpl",
 		Context: map[string]interface{}{
+			"package":     packageOf(g.Package),
 			"controllers": g.Controllers,
+			"secured":     g.secured(),
+			"models":      g.Models.Qualifier(),
+			"validations": g.Validations,
 		},
 	}
 
@@ -42,6 +51,20 @@ func (g *ServerGenerator) Generate() *File {
 		return nil
 	}
 
+	if g.Validations {
+		root.AddNamedImport(g.Models.Name, g.Models.Import)
+	}
+
 	reporter.Notice(" Generating server file: %s successful", filename)
 	return root
 }
+
+func (g *ServerGenerator) secured() bool {
+	for _, controller := range g.Controllers {
+		if controller.IsSecured() {
+			return true
+		}
+	}
+
+	return false
+}
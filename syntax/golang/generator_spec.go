@@ -8,22 +8,25 @@ import (
 	"github.com/phogolabs/stride/syntax"
 )
 
-// SpecGenerator builds the main
+// SpecGenerator builds the test suite of the handlers package
 type SpecGenerator struct {
 	Path     string
+	Package  string
 	Reporter contract.Reporter
 }
 
 // Generate generates a file
 func (g *SpecGenerator) Generate() *File {
-	filename := filepath.Join(g.Path, "service", "suite_test.go")
+	filename := filepath.Join(g.Path, "suite_test.go")
 
 	reporter := g.Reporter.With(contract.SeverityHigh)
 	reporter.Notice(" Generating spec suite file: %s...", filename)
 
 	writer := &syntax.TemplateWriter{
-		Path:    "syntax/golang/spec_suite.go.tpl",
-		Context: map[string]interface{}{},
+		Path: "syntax/golang/spec_suite.go.tpl",
+		Context: map[string]interface{}{
+			"package": packageOf(g.Package),
+		},
 	}
 
 	buffer := &bytes.Buffer{}
@@ -1,8 +1,18 @@
 package golang_test
 
 import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
 	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"github.com/phogolabs/parcello"
 
 	"github.com/phogolabs/stride/codedom"
 	"github.com/phogolabs/stride/fake"
@@ -10,14 +20,20 @@ import (
 )
 
 var _ = Describe("Generator", func() {
-	var generator *golang.Generator
+	var (
+		generator *golang.Generator
+		manager   parcello.FileSystemManager
+	)
 
 	BeforeEach(func() {
+		manager = parcello.Manager
+
 		reporter := &fake.Reporter{}
 		reporter.WithReturns(reporter)
 
 		generator = &golang.Generator{
 			Path:     tmpdir(),
+			Module:   "example.com/app",
 			Reporter: reporter,
 		}
 	})
@@ -42,6 +58,352 @@ var _ = Describe("Generator", func() {
 		Expect(generator.Path + "/service").To(BeADirectory())
 	})
 
+	Context("when the layout is configured", func() {
+		BeforeEach(func() {
+			generator.Models = "internal/model"
+			generator.Handlers = "internal/api"
+			generator.Command = "appd"
+
+			parcello.Manager = parcello.Dir("../../template")
+		})
+
+		AfterEach(func() {
+			parcello.Manager = manager
+		})
+
+		It("generates the packages successfully", func() {
+			descriptor := &codedom.ControllerDescriptor{
+				Name: "account",
+				Operations: codedom.OperationDescriptorCollection{
+					&codedom.OperationDescriptor{
+						Method: "GET",
+						Path:   "/accounts",
+						Name:   "get-accounts",
+					},
+				},
+			}
+
+			spec := &codedom.SpecDescriptor{}
+			spec.Controllers = append(spec.Controllers, descriptor)
+
+			Expect(generator.Generate(spec)).To(Succeed())
+			Expect(filepath.Join(generator.Path, "service")).NotTo(BeADirectory())
+			Expect(filepath.Join(generator.Path, "internal", "model", "schema.go")).To(BeARegularFile())
+			Expect(filepath.Join(generator.Path, "internal", "api", "server.go")).To(BeARegularFile())
+			Expect(filepath.Join(generator.Path, "cmd", "appd", "main.go")).To(BeARegularFile())
+
+			data, err := ioutil.ReadFile(filepath.Join(generator.Path, "internal", "api", "account_api.go"))
+			Expect(err).To(BeNil())
+
+			source := string(data)
+			Expect(source).To(HavePrefix("package api"))
+			Expect(source).To(ContainSubstring(`"example.com/app/internal/model"`))
+			Expect(source).To(ContainSubstring("model.GetAccountsInput"))
+
+			data, err = ioutil.ReadFile(filepath.Join(generator.Path, "cmd", "appd", "main.go"))
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(ContainSubstring(`"example.com/app/internal/api"`))
+		})
+
+		Context("when the models have custom validations", func() {
+			It("registers the custom validations in the server", func() {
+				spec := &codedom.SpecDescriptor{}
+				spec.Types = append(spec.Types, &codedom.TypeDescriptor{
+					Name:    "account",
+					IsClass: true,
+					Properties: codedom.PropertyDescriptorCollection{
+						&codedom.PropertyDescriptor{
+							Name: "code",
+							PropertyType: &codedom.TypeDescriptor{
+								Name:        "string",
+								IsPrimitive: true,
+								Metadata: codedom.Metadata{
+									"pattern": "^[a-z]+$",
+								},
+							},
+						},
+					},
+				})
+
+				Expect(generator.Generate(spec)).To(Succeed())
+
+				data, err := ioutil.ReadFile(filepath.Join(generator.Path, "internal", "model", "validation.go"))
+				Expect(err).To(BeNil())
+				Expect(string(data)).To(ContainSubstring("func NewValidator() *validator.Validate"))
+
+				data, err = ioutil.ReadFile(filepath.Join(generator.Path, "internal", "api", "server.go"))
+				Expect(err).To(BeNil())
+
+				source := string(data)
+				Expect(source).To(ContainSubstring(`"example.com/app/internal/model"`))
+				Expect(source).To(ContainSubstring("restify.SetValidator(model.NewValidator())"))
+			})
+
+			It("does not register the validations without custom tags", func() {
+				Expect(generator.Generate(&codedom.SpecDescriptor{})).To(Succeed())
+
+				data, err := ioutil.ReadFile(filepath.Join(generator.Path, "internal", "api", "server.go"))
+				Expect(err).To(BeNil())
+				Expect(string(data)).NotTo(ContainSubstring("SetValidator"))
+			})
+		})
+
+		Context("when the operations have callbacks", func() {
+			It("generates the receivers and the clients of the callbacks", func() {
+				descriptor := &codedom.ControllerDescriptor{
+					Name: "subscription",
+					Operations: codedom.OperationDescriptorCollection{
+						&codedom.OperationDescriptor{
+							Method: "POST",
+							Path:   "/subscriptions",
+							Name:   "create-subscription",
+							Callbacks: codedom.CallbackDescriptorCollection{
+								&codedom.CallbackDescriptor{
+									Name: "onEvent",
+									Operations: codedom.OperationDescriptorCollection{
+										&codedom.OperationDescriptor{
+											Method: "POST",
+											Path:   "{$request.body#/callbackUrl}/events",
+											Name:   "create-subscription-on-event",
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+
+				spec := &codedom.SpecDescriptor{}
+				spec.Controllers = append(spec.Controllers, descriptor)
+
+				Expect(generator.Generate(spec)).To(Succeed())
+				Expect(filepath.Join(generator.Path, "internal", "model", "subscription_callback_api_model.go")).To(BeARegularFile())
+				Expect(filepath.Join(generator.Path, "client", "subscription_callback_client.go")).To(BeARegularFile())
+
+				data, err := ioutil.ReadFile(filepath.Join(generator.Path, "internal", "api", "subscription_callback_api.go"))
+				Expect(err).To(BeNil())
+				Expect(string(data)).To(ContainSubstring(`r.Post("/events", x.CreateSubscriptionOnEvent)`))
+
+				data, err = ioutil.ReadFile(filepath.Join(generator.Path, "internal", "api", "server.go"))
+				Expect(err).To(BeNil())
+				Expect(string(data)).NotTo(ContainSubstring("SubscriptionCallbackAPI"))
+			})
+		})
+
+		Context("when the package directory is outside of the project", func() {
+			BeforeEach(func() {
+				generator.Models = "../model"
+			})
+
+			It("returns an error", func() {
+				spec := &codedom.SpecDescriptor{}
+				Expect(generator.Generate(spec)).To(MatchError("invalid package directory: ../model. It should be relative to the project directory"))
+			})
+		})
+	})
+
+	Context("when the project is part of a module", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(generator.Path, 0755)).To(Succeed())
+
+			content := []byte("module example.com/bank\n\ngo 1.13\n")
+			Expect(ioutil.WriteFile(filepath.Join(generator.Path, "go.mod"), content, 0644)).To(Succeed())
+
+			parcello.Manager = parcello.Dir("../../template")
+		})
+
+		AfterEach(func() {
+			parcello.Manager = manager
+		})
+
+		It("uses the module path", func() {
+			Expect(generator.Generate(&codedom.SpecDescriptor{})).To(Succeed())
+
+			data, err := ioutil.ReadFile(filepath.Join(generator.Path, "cmd", filepath.Base(generator.Path), "main.go"))
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(ContainSubstring(`"example.com/bank/service"`))
+		})
+	})
+
+	Context("when the generated code is tested", func() {
+		BeforeEach(func() {
+			// the generated packages are tested in the internal directory
+			generator.Module = "github.com/phogolabs/stride/syntax/golang/internal"
+			generator.Validation = true
+			parcello.Manager = parcello.Dir("../../template")
+		})
+
+		AfterEach(func() {
+			parcello.Manager = manager
+		})
+
+		It("generates the tested packages", func() {
+			reporter := &fake.Reporter{}
+			reporter.WithReturns(reporter)
+
+			swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromFile("../../fixture/spec/codecs.yaml")
+			Expect(err).To(BeNil())
+
+			resolver := &codedom.Resolver{
+				Reporter: reporter,
+				Cache:    codedom.TypeDescriptorMap{},
+			}
+
+			spec, err := resolver.Resolve(swagger)
+			Expect(err).To(BeNil())
+			Expect(generator.Generate(spec)).To(Succeed())
+
+			paths, err := filepath.Glob("internal/*/*.go")
+			Expect(err).To(BeNil())
+			Expect(paths).NotTo(BeEmpty())
+
+			for _, path := range paths {
+				name := filepath.Base(path)
+
+				// the tests and the docs are not generated
+				if name == "doc.go" || strings.HasSuffix(name, "_test.go") {
+					continue
+				}
+
+				expected, err := ioutil.ReadFile(path)
+				Expect(err).To(BeNil())
+
+				actual, err := ioutil.ReadFile(filepath.Join(generator.Path, strings.TrimPrefix(path, "internal/")))
+				Expect(err).To(BeNil())
+				Expect(string(actual)).To(Equal(string(expected)), path)
+			}
+		})
+	})
+
+	Context("when the go.mod file should be scaffolded", func() {
+		BeforeEach(func() {
+			generator.GoMod = true
+			parcello.Manager = parcello.Dir("../../template")
+		})
+
+		AfterEach(func() {
+			parcello.Manager = manager
+		})
+
+		It("generates the go.mod file", func() {
+			Expect(generator.Generate(&codedom.SpecDescriptor{})).To(Succeed())
+
+			module, err := golang.ReadModule(filepath.Join(generator.Path, "go.mod"))
+			Expect(err).To(BeNil())
+			Expect(module.Path).To(Equal("example.com/app"))
+		})
+
+		It("requires canonical versions", func() {
+			Expect(generator.Generate(&codedom.SpecDescriptor{})).To(Succeed())
+
+			requires := requiresOf(filepath.Join(generator.Path, "go.mod"))
+			Expect(requires).NotTo(BeEmpty())
+
+			for module, version := range requires {
+				Expect(version).To(MatchRegexp(`^v[0-9]+\.[0-9]+\.[0-9]+(-[0-9A-Za-z.-]+)?(\+incompatible)?$`), module)
+			}
+		})
+
+		table.DescribeTable("requires every import of the generated code",
+			func(path string) {
+				reporter := &fake.Reporter{}
+				reporter.WithReturns(reporter)
+
+				swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromFile(path)
+				Expect(err).To(BeNil())
+
+				resolver := &codedom.Resolver{
+					Reporter: reporter,
+					Cache:    codedom.TypeDescriptorMap{},
+				}
+
+				spec, err := resolver.Resolve(swagger)
+				Expect(err).To(BeNil())
+				Expect(generator.Generate(spec)).To(Succeed())
+
+				var (
+					requires = []string{}
+					pinned   = true
+				)
+
+				for module := range requiresOf(filepath.Join(generator.Path, "go.mod")) {
+					requires = append(requires, module)
+				}
+
+				// the scaffold warns if the go command cannot reach the modules
+				warner := generator.Reporter.(*fake.Reporter)
+
+				for index := 0; index < warner.WarnCallCount(); index++ {
+					if msg, _ := warner.WarnArgsForCall(index); strings.HasPrefix(msg, " Pinning module dependencies fail") {
+						pinned = false
+					}
+				}
+
+				err = filepath.Walk(generator.Path, func(name string, info os.FileInfo, err error) error {
+					if err != nil || filepath.Ext(name) != ".go" {
+						return err
+					}
+
+					file, err := parser.ParseFile(token.NewFileSet(), name, nil, parser.ImportsOnly)
+					Expect(err).To(BeNil())
+
+					for _, spec := range file.Imports {
+						pkg := strings.Trim(spec.Path.Value, `"`)
+
+						// the standard library and the project do not need a require
+						if !strings.Contains(strings.Split(pkg, "/")[0], ".") || strings.HasPrefix(pkg, "example.com/app/") {
+							continue
+						}
+
+						// the unreleased modules are not required if they cannot be pinned
+						if !pinned && (strings.HasPrefix(pkg, "github.com/phogolabs/restify") || strings.HasPrefix(pkg, "github.com/phogolabs/schema")) {
+							continue
+						}
+
+						Expect(requires).To(ContainElement(WithTransform(func(module string) bool {
+							return pkg == module || strings.HasPrefix(pkg, module+"/")
+						}, BeTrue())), pkg)
+					}
+
+					return nil
+				})
+
+				Expect(err).To(BeNil())
+			},
+			table.Entry("web-api", "../../fixture/spec/web-api.yaml"),
+			table.Entry("codecs", "../../fixture/spec/codecs.yaml"),
+			table.Entry("security", "../../fixture/spec/security.yaml"),
+			table.Entry("callbacks", "../../fixture/spec/callbacks.yaml"),
+		)
+
+		Context("when the project is part of a module", func() {
+			BeforeEach(func() {
+				Expect(os.MkdirAll(generator.Path, 0755)).To(Succeed())
+
+				content := []byte("module example.com/bank\n")
+				Expect(ioutil.WriteFile(filepath.Join(generator.Path, "go.mod"), content, 0644)).To(Succeed())
+			})
+
+			It("does not overwrite the go.mod file", func() {
+				Expect(generator.Generate(&codedom.SpecDescriptor{})).To(Succeed())
+
+				data, err := ioutil.ReadFile(filepath.Join(generator.Path, "go.mod"))
+				Expect(err).To(BeNil())
+				Expect(string(data)).To(Equal("module example.com/bank\n"))
+			})
+		})
+	})
+
+	Context("when the import path cannot be resolved", func() {
+		BeforeEach(func() {
+			generator.Module = ""
+		})
+
+		It("returns an error", func() {
+			Expect(generator.Generate(&codedom.SpecDescriptor{})).To(MatchError(ContainSubstring("cannot resolve the import path")))
+		})
+	})
+
 	Context("when cannot create the directory", func() {
 		BeforeEach(func() {
 			generator.Path = "/my-dir"
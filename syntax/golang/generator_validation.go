@@ -0,0 +1,106 @@
+package golang
+
+import (
+	"bytes"
+	"path/filepath"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/syntax"
+)
+
+// ValidationGenerator builds the custom validations
+type ValidationGenerator struct {
+	Path        string
+	Package     string
+	Validation  bool
+	Collection  codedom.TypeDescriptorCollection
+	Controllers codedom.ControllerDescriptorCollection
+	Reporter    contract.Reporter
+}
+
+// Generate generates a file
+func (g *ValidationGenerator) Generate() *File {
+	filename := filepath.Join(g.Path, "validation.go")
+
+	var (
+		tags    = hasCustomTags(g.Collection, g.Controllers)
+		methods = g.Validation
+	)
+
+	// the custom validations are needed only by the custom tags and the methods
+	if !tags && !methods {
+		return nil
+	}
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+	reporter.Notice(" Generating validation file: %s...", filename)
+
+	writer := &syntax.TemplateWriter{
+		Path: "syntax/golang/validation.go.tpl",
+		Context: map[string]interface{}{
+			"package": packageOf(g.Package),
+			"tags":    tags,
+			"methods": methods,
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	if _, err := writer.WriteTo(buffer); err != nil {
+		reporter.Error(" Generating validation file: %s fail: %v", filename, err)
+		return nil
+	}
+
+	root, err := ReadFile(filename, buffer)
+	if err != nil {
+		reporter.Error(" Generating validation file: %s fail: %v", filename, err)
+		return nil
+	}
+
+	reporter.Notice(" Generating validation file: %s successful", filename)
+	return root
+}
+
+// hasCustomTags returns true if the types or the parameters have tags that are
+// validated by the custom validations
+func hasCustomTags(types codedom.TypeDescriptorCollection, controllers codedom.ControllerDescriptorCollection) bool {
+	custom := func(metadata codedom.Metadata) bool {
+		return metadata.HasPattern() || metadata.HasMultipleOf()
+	}
+
+	for _, descriptor := range types {
+		for _, property := range descriptor.Properties {
+			if custom(property.PropertyType.Metadata) {
+				return true
+			}
+		}
+	}
+
+	has := func(parameters codedom.ParameterDescriptorCollection) bool {
+		for _, parameter := range parameters {
+			if custom(parameter.ParameterType.Metadata) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, controller := range controllers {
+		for _, operation := range controller.Operations {
+			for _, request := range operation.Requests {
+				if has(request.Parameters) {
+					return true
+				}
+			}
+
+			for _, response := range operation.Responses {
+				if has(response.Parameters) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
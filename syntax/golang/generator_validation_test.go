@@ -0,0 +1,120 @@
+package golang_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/syntax/golang"
+)
+
+var _ = Describe("ValidationGenerator", func() {
+	var generator *golang.ValidationGenerator
+
+	BeforeEach(func() {
+		reporter := &fake.Reporter{}
+		reporter.WithReturns(reporter)
+
+		generator = &golang.ValidationGenerator{
+			Path:     tmpdir(),
+			Reporter: reporter,
+			Collection: codedom.TypeDescriptorCollection{
+				&codedom.TypeDescriptor{
+					Name:    "User",
+					IsClass: true,
+					Properties: codedom.PropertyDescriptorCollection{
+						&codedom.PropertyDescriptor{
+							Name: "name",
+							PropertyType: &codedom.TypeDescriptor{
+								Name:        "string",
+								IsPrimitive: true,
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Context("when the types do not have patterns", func() {
+		It("does not generate the file", func() {
+			Expect(generator.Generate()).To(BeNil())
+
+			reporter := generator.Reporter.(*fake.Reporter)
+			Expect(reporter.NoticeCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the validation methods are enabled", func() {
+		BeforeEach(func() {
+			generator.Validation = true
+		})
+
+		It("generates the file", func() {
+			generator.Generate()
+
+			reporter := generator.Reporter.(*fake.Reporter)
+			Expect(reporter.NoticeCallCount()).NotTo(BeZero())
+		})
+	})
+
+	Context("when the properties have multiple of constraints", func() {
+		BeforeEach(func() {
+			factor := 0.5
+
+			generator.Collection[0].Properties[0].PropertyType = &codedom.TypeDescriptor{
+				Name:        "float64",
+				IsPrimitive: true,
+				Metadata: codedom.Metadata{
+					"multiple_of": &factor,
+				},
+			}
+		})
+
+		It("generates the file", func() {
+			generator.Generate()
+
+			reporter := generator.Reporter.(*fake.Reporter)
+			Expect(reporter.NoticeCallCount()).NotTo(BeZero())
+		})
+	})
+
+	Context("when the parameters have patterns", func() {
+		BeforeEach(func() {
+			generator.Controllers = codedom.ControllerDescriptorCollection{
+				&codedom.ControllerDescriptor{
+					Name: "user",
+					Operations: codedom.OperationDescriptorCollection{
+						&codedom.OperationDescriptor{
+							Name: "get-user",
+							Requests: codedom.RequestDescriptorCollection{
+								&codedom.RequestDescriptor{
+									Parameters: codedom.ParameterDescriptorCollection{
+										&codedom.ParameterDescriptor{
+											Name: "code",
+											ParameterType: &codedom.TypeDescriptor{
+												Name:        "string",
+												IsPrimitive: true,
+												Metadata: codedom.Metadata{
+													"pattern": "^[a-z]+$",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("generates the file", func() {
+			generator.Generate()
+
+			reporter := generator.Reporter.(*fake.Reporter)
+			Expect(reporter.NoticeCallCount()).NotTo(BeZero())
+		})
+	})
+})
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type doer struct {
+	request *http.Request
+}
+
+func (d *doer) Do(r *http.Request) (*http.Response, error) {
+	d.request = r
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+type filter struct {
+	Role  string `json:"role"`
+	Limit int    `json:"limit"`
+}
+
+var _ = Describe("Client", func() {
+	var (
+		client  *Client
+		fake    *doer
+		request *request
+	)
+
+	BeforeEach(func() {
+		fake = &doer{}
+		client = &Client{URL: "http://example.com/api", Doer: fake}
+		request = newRequest("GET", "/users/{id}")
+	})
+
+	send := func() *http.Request {
+		_, err := client.do(context.TODO(), request)
+		Expect(err).To(BeNil())
+		return fake.request
+	}
+
+	Describe("do", func() {
+		It("keeps the query of the client url", func() {
+			client.URL = "http://example.com/api?key=abc"
+			request.setPath("id", "simple", false, 5)
+			request.setQuery("limit", "form", true, 10)
+
+			url := send().URL
+			Expect(url.Path).To(Equal("/api/users/5"))
+			Expect(url.Query()).To(HaveKeyWithValue("key", []string{"abc"}))
+			Expect(url.Query()).To(HaveKeyWithValue("limit", []string{"10"}))
+		})
+
+		It("keeps the query of the callback url", func() {
+			request = newRequest("POST", "")
+			request.url = "https://subscriber.example.com/cb?token=abc"
+			request.setQuery("event", "form", true, "created")
+
+			url := send().URL
+			Expect(url.Host).To(Equal("subscriber.example.com"))
+			Expect(url.Query()).To(HaveKeyWithValue("token", []string{"abc"}))
+			Expect(url.Query()).To(HaveKeyWithValue("event", []string{"created"}))
+		})
+
+		It("does not change the query without parameters", func() {
+			request = newRequest("POST", "")
+			request.url = "https://subscriber.example.com/cb?b=2&a=1"
+
+			Expect(send().URL.RawQuery).To(Equal("b=2&a=1"))
+		})
+	})
+
+	Describe("setPath", func() {
+		DescribeTable("encodes the path parameters",
+			func(style string, explode bool, value interface{}, path string) {
+				request.setPath("id", style, explode, value)
+				Expect(send().URL.EscapedPath()).To(Equal("/api/users/" + path))
+			},
+			Entry("simple", "simple", false, 5, "5"),
+			Entry("simple array", "simple", false, []int{3, 4, 5}, "3,4,5"),
+			Entry("simple object", "simple", false, map[string]int{"r": 100, "g": 200}, "g,200,r,100"),
+			Entry("simple object exploded", "simple", true, map[string]int{"r": 100, "g": 200}, "g=200,r=100"),
+			Entry("label", "label", false, 5, ".5"),
+			Entry("label array", "label", false, []int{3, 4, 5}, ".3,4,5"),
+			Entry("label array exploded", "label", true, []int{3, 4, 5}, ".3.4.5"),
+			Entry("matrix", "matrix", false, 5, ";id=5"),
+			Entry("matrix array", "matrix", false, []int{3, 4, 5}, ";id=3,4,5"),
+			Entry("matrix array exploded", "matrix", true, []int{3, 4, 5}, ";id=3;id=4;id=5"),
+			Entry("matrix object exploded", "matrix", true, map[string]int{"r": 100, "g": 200}, ";g=200;r=100"),
+			Entry("escaped", "simple", false, "a b/c", "a%20b%2Fc"),
+		)
+	})
+
+	Describe("setQuery", func() {
+		DescribeTable("encodes the query parameters",
+			func(style string, explode bool, value interface{}, query string) {
+				request.setQuery("id", style, explode, value)
+				Expect(send().URL.RawQuery).To(Equal(query))
+			},
+			Entry("form", "form", true, 5, "id=5"),
+			Entry("form array", "form", false, []int{3, 4, 5}, "id=3%2C4%2C5"),
+			Entry("form array exploded", "form", true, []int{3, 4, 5}, "id=3&id=4&id=5"),
+			Entry("form object", "form", false, &filter{Role: "admin", Limit: 10}, "id=role%2Cadmin%2Climit%2C10"),
+			Entry("form object exploded", "form", true, &filter{Role: "admin", Limit: 10}, "limit=10&role=admin"),
+			Entry("space delimited", "spaceDelimited", false, []int{3, 4, 5}, "id=3+4+5"),
+			Entry("pipe delimited", "pipeDelimited", false, []int{3, 4, 5}, "id=3%7C4%7C5"),
+			Entry("deep object", "deepObject", true, map[string]string{"role": "admin"}, "id%5Brole%5D=admin"),
+		)
+
+		It("does not send the unset optional parameters", func() {
+			var (
+				limit  int32
+				status string
+				ids    []int
+			)
+
+			request.setQuery("limit", "form", true, optional(limit))
+			request.setQuery("status", "form", true, optional(status))
+			request.setQuery("ids", "form", true, optional(ids))
+			request.setQuery("ids", "form", true, optional([]int{}))
+
+			Expect(send().URL.RawQuery).To(BeEmpty())
+		})
+
+		It("sends the set optional parameters", func() {
+			limit := 0
+
+			request.setQuery("limit", "form", true, optional(&limit))
+			request.setQuery("status", "form", true, optional("pending"))
+
+			Expect(send().URL.RawQuery).To(Equal("limit=0&status=pending"))
+		})
+
+		It("sends the zero value of the required parameters", func() {
+			request.setQuery("limit", "form", true, 0)
+			Expect(send().URL.RawQuery).To(Equal("limit=0"))
+		})
+	})
+
+	Describe("setHeader", func() {
+		It("encodes the header parameters", func() {
+			request.setHeader("X-Values", "simple", false, []int{3, 4, 5})
+			request.setHeader("X-Object", "simple", true, map[string]int{"r": 100, "g": 200})
+
+			header := send().Header
+			Expect(header.Get("X-Values")).To(Equal("3,4,5"))
+			Expect(header.Get("X-Object")).To(Equal("g=200,r=100"))
+		})
+
+		It("does not send the unset optional parameters", func() {
+			request.setHeader("X-Request-ID", "simple", false, optional(""))
+			Expect(send().Header).NotTo(HaveKey("X-Request-Id"))
+		})
+	})
+
+	Describe("setCookie", func() {
+		It("encodes the cookie parameters", func() {
+			request.setCookie("session", "form", true, "abc")
+			request.setCookie("ids", "form", false, []int{3, 4, 5})
+
+			req := send()
+
+			session, err := req.Cookie("session")
+			Expect(err).To(BeNil())
+			Expect(session.Value).To(Equal("abc"))
+
+			ids, err := req.Cookie("ids")
+			Expect(err).To(BeNil())
+			Expect(ids.Value).To(Equal("3,4,5"))
+		})
+
+		It("does not send the unset optional parameters", func() {
+			request.setCookie("session", "form", true, optional(""))
+			Expect(send().Header.Get("Cookie")).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,353 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/syntax/golang/internal/service"
+)
+
+var _ = Describe("Codec", func() {
+	var (
+		server  *httptest.Server
+		handler http.HandlerFunc
+		client  *Client
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			handler(w, r)
+		}))
+
+		client = New(server.URL)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("when the body is vendor JSON", func() {
+		It("round trips the body", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("Content-Type")).To(Equal("application/vnd.api+json"))
+
+				input := &service.CreateNoteInput{}
+
+				decoded, err := service.DecodeBody(r, input)
+				Expect(err).To(BeNil())
+				Expect(decoded).To(BeTrue())
+
+				w.Header().Set("Content-Type", "application/vnd.api+json")
+				w.WriteHeader(http.StatusCreated)
+				Expect(json.NewEncoder(w).Encode(&service.CreateNoteCreatedOutput{Body: input.Body})).To(Succeed())
+			}
+
+			note := &service.Note{Title: "groceries", Tags: service.NoteTags{"home"}}
+
+			response, err := NewNoteClient(client).CreateNote(context.TODO(), &service.CreateNoteInput{Body: note})
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			Expect(response.Created.Body).To(Equal(note))
+		})
+	})
+
+	Context("when the body has read-only and write-only properties", func() {
+		BeforeEach(func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				input := &service.CreateCredentialInput{}
+				Expect(json.NewDecoder(r.Body).Decode(input)).To(Succeed())
+
+				// the generated handler validates the input after binding
+				if err := input.ValidateRequest(); err != nil {
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					Expect(json.NewEncoder(w).Encode(err)).To(Succeed())
+					return
+				}
+
+				output := &service.CreateCredentialCreatedOutput{
+					Body: &service.Credential{ID: "c1", Name: input.Body.Name},
+				}
+
+				// the secret is sent back by mistake
+				if input.Body.Name == "leak" {
+					output.Body.Secret = input.Body.Secret
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				Expect(json.NewEncoder(w).Encode(output)).To(Succeed())
+			}
+		})
+
+		It("round trips the body", func() {
+			input := &service.CreateCredentialInput{
+				Body: &service.Credential{Name: "ci", Secret: "s3cr3t"},
+			}
+
+			response, err := NewCredentialClient(client).CreateCredential(context.TODO(), input)
+			Expect(err).To(BeNil())
+			Expect(response.Created.Body).To(Equal(&service.Credential{ID: "c1", Name: "ci"}))
+		})
+
+		It("rejects the read-only properties of the request", func() {
+			input := &service.CreateCredentialInput{
+				Body: &service.Credential{ID: "c1", Name: "ci", Secret: "s3cr3t"},
+			}
+
+			_, err := NewCredentialClient(client).CreateCredential(context.TODO(), input)
+			Expect(err).To(BeAssignableToTypeOf(&ResponseError{}))
+
+			failure := err.(*ResponseError)
+			Expect(failure.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+			Expect(string(failure.Body)).To(MatchJSON(`[{"field":"id","message":"is read-only"}]`))
+		})
+
+		It("rejects the write-only properties of the response", func() {
+			input := &service.CreateCredentialInput{
+				Body: &service.Credential{Name: "leak", Secret: "s3cr3t"},
+			}
+
+			_, err := NewCredentialClient(client).CreateCredential(context.TODO(), input)
+			Expect(err).To(MatchError("secret: is write-only"))
+		})
+	})
+
+	Context("when the body is plain text", func() {
+		It("round trips the body", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/notes/n1/text"))
+
+				input := &service.UpdateNoteTextInput{}
+
+				decoded, err := service.DecodeBody(r, input)
+				Expect(err).To(BeNil())
+				Expect(decoded).To(BeTrue())
+				Expect(input.TextBody).To(Equal("buy milk"))
+
+				output := &service.UpdateNoteTextOKOutput{Body: strings.ToUpper(input.TextBody)}
+
+				data, err := output.MarshalText()
+				Expect(err).To(BeNil())
+
+				w.Header().Set("Content-Type", "text/plain")
+				w.Write(data)
+			}
+
+			input := &service.UpdateNoteTextInput{
+				Path:     &service.UpdateNoteTextInputPath{NoteID: "n1"},
+				TextBody: "buy milk",
+			}
+
+			response, err := NewNoteClient(client).UpdateNoteText(context.TODO(), input)
+			Expect(err).To(BeNil())
+			Expect(response.OK.Body).To(Equal("BUY MILK"))
+		})
+
+		It("sends the empty body", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("Content-Type")).To(Equal("text/plain"))
+
+				input := &service.UpdateNoteTextInput{}
+
+				decoded, err := service.DecodeBody(r, input)
+				Expect(err).To(BeNil())
+				Expect(decoded).To(BeTrue())
+				Expect(input.TextBody).To(BeEmpty())
+
+				w.Header().Set("Content-Type", "text/plain")
+				w.Write([]byte("empty"))
+			}
+
+			input := &service.UpdateNoteTextInput{
+				Path: &service.UpdateNoteTextInputPath{NoteID: "n1"},
+			}
+
+			response, err := NewNoteClient(client).UpdateNoteText(context.TODO(), input)
+			Expect(err).To(BeNil())
+			Expect(response.OK.Body).To(Equal("empty"))
+		})
+	})
+
+	Context("when the body is binary", func() {
+		It("streams the request body", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				input := &service.UpdateNoteTextInput{}
+
+				decoded, err := service.DecodeBody(r, input)
+				Expect(err).To(BeNil())
+				Expect(decoded).To(BeTrue())
+				Expect(input.BinaryBody).NotTo(BeNil())
+				Expect(input.BinaryBody.ContentType).To(Equal("application/octet-stream"))
+
+				data, err := ioutil.ReadAll(input.BinaryBody)
+				Expect(err).To(BeNil())
+
+				w.Header().Set("Content-Type", "text/plain")
+				w.Write(data)
+			}
+
+			input := &service.UpdateNoteTextInput{
+				Path:       &service.UpdateNoteTextInputPath{NoteID: "n1"},
+				BinaryBody: &service.File{Reader: strings.NewReader("\x00\x01binary")},
+			}
+
+			response, err := NewNoteClient(client).UpdateNoteText(context.TODO(), input)
+			Expect(err).To(BeNil())
+			Expect(response.OK.Body).To(Equal("\x00\x01binary"))
+		})
+
+		It("streams the response body", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				output := &service.DownloadDocumentOKOutput{
+					Body: &service.File{
+						Reader:      strings.NewReader("%PDF"),
+						ContentType: "application/pdf",
+					},
+				}
+
+				w.Header().Set("Content-Type", output.ContentType())
+				_, err := output.WriteTo(w)
+				Expect(err).To(BeNil())
+			}
+
+			input := &service.DownloadDocumentInput{
+				Path: &service.DownloadDocumentInputPath{DocumentID: "d1"},
+			}
+
+			response, err := NewDocumentClient(client).DownloadDocument(context.TODO(), input)
+			Expect(err).To(BeNil())
+			Expect(response.OK.Body.ContentType).To(Equal("application/pdf"))
+
+			data, err := ioutil.ReadAll(response.OK.Body)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal("%PDF"))
+		})
+	})
+
+	Context("when the body is multipart", func() {
+		It("round trips the parts", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				input := &service.UploadDocumentInput{}
+
+				decoded, err := service.DecodeBody(r, input)
+				Expect(err).To(BeNil())
+				Expect(decoded).To(BeTrue())
+
+				body := input.Body
+				Expect(body.File.Name).To(Equal("report.pdf"))
+				Expect(body.File.ContentType).To(Equal("application/pdf"))
+
+				data, err := ioutil.ReadAll(body.File)
+				Expect(err).To(BeNil())
+				Expect(string(data)).To(Equal("%PDF"))
+
+				Expect(body.Attachments).To(HaveLen(2))
+				Expect(body.Attachments[1].Name).To(Equal("b.txt"))
+				Expect(body.Metadata.Title).To(Equal("Report"))
+				Expect(body.Tags).To(Equal(service.DocumentUploadTags{"q1", "finance"}))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				Expect(json.NewEncoder(w).Encode(&service.UploadDocumentCreatedOutput{
+					Body: &service.Document{ID: "d1", Title: body.Metadata.Title},
+				})).To(Succeed())
+			}
+
+			input := &service.UploadDocumentInput{
+				Body: &service.DocumentUpload{
+					File: &service.File{Reader: strings.NewReader("%PDF"), Name: "report.pdf"},
+					Attachments: service.DocumentUploadAttachments{
+						&service.File{Reader: strings.NewReader("a"), Name: "a.txt"},
+						&service.File{Reader: strings.NewReader("b"), Name: "b.txt"},
+					},
+					Metadata: &service.DocumentUploadMetadata{Title: "Report"},
+					Tags:     service.DocumentUploadTags{"q1", "finance"},
+				},
+			}
+
+			response, err := NewDocumentClient(client).UploadDocument(context.TODO(), input)
+			Expect(err).To(BeNil())
+			Expect(response.Created.Body).To(Equal(&service.Document{ID: "d1", Title: "Report"}))
+		})
+	})
+
+	Context("when the body is a form", func() {
+		It("encodes the fields as form values", func() {
+			request := newRequest("POST", "/notes")
+			request.setBody("application/x-www-form-urlencoded", &service.Note{
+				Title: "groceries",
+				Tags:  service.NoteTags{"home", "food"},
+			})
+
+			Expect(request.err).To(BeNil())
+			Expect(request.header.Get("Content-Type")).To(Equal("application/x-www-form-urlencoded"))
+
+			values, err := url.ParseQuery(string(request.body))
+			Expect(err).To(BeNil())
+			Expect(values).To(Equal(url.Values{
+				"title": []string{"groceries"},
+				"tags":  []string{"home", "food"},
+			}))
+		})
+
+		It("omits the empty fields", func() {
+			request := newRequest("POST", "/notes")
+			request.setBody("application/x-www-form-urlencoded", &service.Note{
+				Tags: service.NoteTags{"home"},
+			})
+
+			Expect(request.err).To(BeNil())
+
+			values, err := url.ParseQuery(string(request.body))
+			Expect(err).To(BeNil())
+			Expect(values).To(Equal(url.Values{
+				"tags": []string{"home"},
+			}))
+		})
+	})
+
+	Context("when the body is decoded by the binder", func() {
+		It("does not decode the body", func() {
+			r := httptest.NewRequest("POST", "/notes", strings.NewReader(`{"title":"groceries"}`))
+			r.Header.Set("Content-Type", "application/json")
+
+			input := &service.CreateNoteInput{}
+
+			decoded, err := service.DecodeBody(r, input)
+			Expect(err).To(BeNil())
+			Expect(decoded).To(BeFalse())
+			Expect(input.Body).To(BeNil())
+		})
+	})
+
+	Describe("WithoutBody", func() {
+		It("returns the request without the body", func() {
+			r := httptest.NewRequest("PUT", "/notes/n1/text?draft=true", strings.NewReader("buy milk"))
+			r.Header.Set("Content-Type", "text/plain")
+			r.Header.Set("X-Request-ID", "r1")
+
+			request := service.WithoutBody(r)
+			Expect(request.Body).To(Equal(http.NoBody))
+			Expect(request.ContentLength).To(BeZero())
+			Expect(request.Header.Get("Content-Type")).To(BeEmpty())
+			Expect(request.Header.Get("X-Request-ID")).To(Equal("r1"))
+			Expect(request.URL.Query().Get("draft")).To(Equal("true"))
+
+			Expect(r.Header.Get("Content-Type")).To(Equal("text/plain"))
+
+			data, err := ioutil.ReadAll(r.Body)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal("buy milk"))
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/phogolabs/stride/syntax/golang/internal/service"
+)
+
+// CredentialClient is a type auto-generated from OpenAPI spec
+// stride:generate credential-client
+type CredentialClient struct {
+	// stride:generate client
+	Client *Client
+}
+
+// NewCredentialClient creates a new client for the credential operations
+// stride:generate new:credential-client
+func NewCredentialClient(client *Client) *CredentialClient {
+	return &CredentialClient{
+		Client: client,
+	}
+}
+
+// CreateCredentialResponse is a type auto-generated from OpenAPI spec
+// It is the response of CreateCredential operation
+// stride:generate create-credential-response
+type CreateCredentialResponse struct {
+	// stride:generate status-code
+	StatusCode int
+	// stride:generate header
+	Header http.Header
+	// stride:generate created
+	Created *service.CreateCredentialCreatedOutput
+}
+
+// CreateCredential calls endpoint POST /credentials
+// stride:generate credential-client:create-credential
+func (x *CredentialClient) CreateCredential(ctx context.Context, input *service.CreateCredentialInput) (*CreateCredentialResponse, error) {
+	request := newRequest("POST", "/credentials")
+
+	request.setBody("application/json", input.Body)
+
+	request.setAccept("application/json")
+
+	response, err := x.Client.do(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	output := &CreateCredentialResponse{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+	}
+
+	switch response.StatusCode {
+	case 201:
+		output.Created = &service.CreateCredentialCreatedOutput{}
+
+		if err := decodeBody(response, &output.Created.Body); err != nil {
+			return nil, err
+		}
+
+		if err := output.Created.ValidateResponse(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, decodeError(response)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,6 @@
+// Package client is a client generated from fixture/spec/codecs.yaml. The
+// Generator tests keep it in sync with the templates, so the runtime of the
+// generated clients is tested as it's generated. Regenerate it with
+// --module github.com/phogolabs/stride/syntax/golang/internal when the
+// templates change.
+package client
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/phogolabs/stride/syntax/golang/internal/service"
+)
+
+// DocumentClient is a type auto-generated from OpenAPI spec
+// stride:generate document-client
+type DocumentClient struct {
+	// stride:generate client
+	Client *Client
+}
+
+// NewDocumentClient creates a new client for the document operations
+// stride:generate new:document-client
+func NewDocumentClient(client *Client) *DocumentClient {
+	return &DocumentClient{
+		Client: client,
+	}
+}
+
+// DownloadDocumentResponse is a type auto-generated from OpenAPI spec
+// It is the response of DownloadDocument operation
+// stride:generate download-document-response
+type DownloadDocumentResponse struct {
+	// stride:generate status-code
+	StatusCode int
+	// stride:generate header
+	Header http.Header
+	// stride:generate ok
+	OK *service.DownloadDocumentOKOutput
+}
+
+// DownloadDocument calls endpoint GET /documents/{documentId}/content
+// stride:generate document-client:download-document
+func (x *DocumentClient) DownloadDocument(ctx context.Context, input *service.DownloadDocumentInput) (*DownloadDocumentResponse, error) {
+	request := newRequest("GET", "/documents/{documentId}/content")
+
+	if input.Path != nil {
+		request.setPath("documentId", "simple", false, input.Path.DocumentID)
+	}
+
+	request.setAccept("application/octet-stream")
+
+	response, err := x.Client.do(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	output := &DownloadDocumentResponse{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+	}
+
+	switch response.StatusCode {
+	case 200:
+		output.OK = &service.DownloadDocumentOKOutput{}
+
+		data, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		output.OK.Body = &service.File{
+			Reader:      bytes.NewReader(data),
+			ContentType: response.Header.Get("Content-Type"),
+		}
+	default:
+		return nil, decodeError(response)
+	}
+
+	return output, nil
+}
+
+// UploadDocumentResponse is a type auto-generated from OpenAPI spec
+// It is the response of UploadDocument operation
+// stride:generate upload-document-response
+type UploadDocumentResponse struct {
+	// stride:generate status-code
+	StatusCode int
+	// stride:generate header
+	Header http.Header
+	// stride:generate created
+	Created *service.UploadDocumentCreatedOutput
+}
+
+// UploadDocument calls endpoint POST /documents
+// stride:generate document-client:upload-document
+func (x *DocumentClient) UploadDocument(ctx context.Context, input *service.UploadDocumentInput) (*UploadDocumentResponse, error) {
+	request := newRequest("POST", "/documents")
+
+	request.setMultipart(input.Body, map[string]string{"file": "application/pdf", "metadata": "application/json"})
+
+	request.setAccept("application/json")
+
+	response, err := x.Client.do(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	output := &UploadDocumentResponse{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+	}
+
+	switch response.StatusCode {
+	case 201:
+		output.Created = &service.UploadDocumentCreatedOutput{}
+
+		if err := decodeBody(response, &output.Created.Body); err != nil {
+			return nil, err
+		}
+
+		if err := output.Created.ValidateResponse(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, decodeError(response)
+	}
+
+	return output, nil
+}
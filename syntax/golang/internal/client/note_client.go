@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/phogolabs/stride/syntax/golang/internal/service"
+)
+
+// NoteClient is a type auto-generated from OpenAPI spec
+// stride:generate note-client
+type NoteClient struct {
+	// stride:generate client
+	Client *Client
+}
+
+// NewNoteClient creates a new client for the note operations
+// stride:generate new:note-client
+func NewNoteClient(client *Client) *NoteClient {
+	return &NoteClient{
+		Client: client,
+	}
+}
+
+// CreateNoteResponse is a type auto-generated from OpenAPI spec
+// It is the response of CreateNote operation
+// stride:generate create-note-response
+type CreateNoteResponse struct {
+	// stride:generate status-code
+	StatusCode int
+	// stride:generate header
+	Header http.Header
+	// stride:generate created
+	Created *service.CreateNoteCreatedOutput
+}
+
+// CreateNote calls endpoint POST /notes
+// stride:generate note-client:create-note
+func (x *NoteClient) CreateNote(ctx context.Context, input *service.CreateNoteInput) (*CreateNoteResponse, error) {
+	request := newRequest("POST", "/notes")
+
+	request.setBody("application/vnd.api+json", input.Body)
+
+	request.setAccept("application/vnd.api+json", "application/xml")
+
+	response, err := x.Client.do(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	output := &CreateNoteResponse{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+	}
+
+	switch response.StatusCode {
+	case 201:
+		output.Created = &service.CreateNoteCreatedOutput{}
+
+		if err := decodeBody(response, &output.Created.Body); err != nil {
+			return nil, err
+		}
+
+		if err := output.Created.ValidateResponse(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, decodeError(response)
+	}
+
+	return output, nil
+}
+
+// UpdateNoteTextResponse is a type auto-generated from OpenAPI spec
+// It is the response of UpdateNoteText operation
+// stride:generate update-note-text-response
+type UpdateNoteTextResponse struct {
+	// stride:generate status-code
+	StatusCode int
+	// stride:generate header
+	Header http.Header
+	// stride:generate ok
+	OK *service.UpdateNoteTextOKOutput
+	// stride:generate not-found
+	NotFound *service.UpdateNoteTextNotFoundOutput
+}
+
+// UpdateNoteText calls endpoint PUT /notes/{noteId}/text
+// stride:generate note-client:update-note-text
+func (x *NoteClient) UpdateNoteText(ctx context.Context, input *service.UpdateNoteTextInput) (*UpdateNoteTextResponse, error) {
+	request := newRequest("PUT", "/notes/{noteId}/text")
+
+	if input.Path != nil {
+		request.setPath("noteId", "simple", false, input.Path.NoteID)
+	}
+
+	request.setBody("application/octet-stream", input.BinaryBody)
+
+	request.setBody("text/plain", input.TextBody)
+
+	request.setAccept("text/csv", "text/plain")
+
+	response, err := x.Client.do(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	output := &UpdateNoteTextResponse{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+	}
+
+	switch response.StatusCode {
+	case 200:
+		output.OK = &service.UpdateNoteTextOKOutput{}
+
+		if err := decodeBody(response, &output.OK.Body); err != nil {
+			return nil, err
+		}
+	case 404:
+		output.NotFound = &service.UpdateNoteTextNotFoundOutput{}
+
+		if err := decodeBody(response, &output.NotFound.Body); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, decodeError(response)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,69 @@
+package service
+
+import "encoding/json"
+
+// CreateCredentialInput is a type auto-generated from OpenAPI spec
+// It is the input of CreateCredential operation
+// stride:generate create-credential-input
+type CreateCredentialInput struct {
+	// stride:generate body
+	Body *Credential `body:"~" form:"~"`
+}
+
+// UnmarshalJSON unmarshals from valid JSON
+// stride:generate create-credential-input:unmarshal-j-s-o-n
+func (x *CreateCredentialInput) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &x.Body)
+}
+
+// ValidateRequest validates the value of a request and returns the field errors.
+// The read-only properties must not be set.
+// stride:generate create-credential-input:validate-request
+func (x CreateCredentialInput) ValidateRequest() error {
+	errs := ValidationErrors{}
+	if x.Body != nil {
+		errs.Merge("", (*x.Body).ValidateRequest())
+	}
+
+	return errs.Err()
+}
+
+// CreateCredentialCreatedOutput is a type auto-generated from OpenAPI spec
+// It is the output of CreateCredential operation with code: 201
+// stride:generate create-credential-created-output
+type CreateCredentialCreatedOutput struct {
+	// stride:generate body
+	Body *Credential `body:"~"`
+}
+
+// MarshalJSON marshals into valid JSON
+// stride:generate create-credential-created-output:marshal-j-s-o-n
+func (x CreateCredentialCreatedOutput) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.Body)
+}
+
+// ValidateResponse validates the value of a response and returns the field errors.
+// The write-only properties must not be set.
+// stride:generate create-credential-created-output:validate-response
+func (x CreateCredentialCreatedOutput) ValidateResponse() error {
+	errs := ValidationErrors{}
+	if x.Body != nil {
+		errs.Merge("", (*x.Body).ValidateResponse())
+	}
+
+	return errs.Err()
+}
+
+// Status returns an http status code
+// stride:generate create-credential-created-output:status
+func (x *CreateCredentialCreatedOutput) Status() int {
+	// stride:define body:start
+	// NOTE: not implemented
+	// stride:define body:end
+	return 201
+}
+
+// CreateCredentialOutput is a type auto-generated from OpenAPI spec
+// It is the alias to the default output of CreateCredential operation
+// stride:generate create-credential-output
+type CreateCredentialOutput CreateCredentialCreatedOutput
@@ -0,0 +1,6 @@
+// Package service is the models package generated from
+// fixture/spec/codecs.yaml. The Generator tests keep it in sync with the
+// templates, so the codecs of the generated models are tested as they're
+// generated. Regenerate it with --module
+// github.com/phogolabs/stride/syntax/golang/internal when the templates change.
+package service
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"encoding/json"
+	"io"
+	"mime/multipart"
+)
+
+// DownloadDocumentInput is a type auto-generated from OpenAPI spec
+// It is the input of DownloadDocument operation
+// stride:generate download-document-input
+type DownloadDocumentInput struct {
+	// stride:generate path
+	Path *DownloadDocumentInputPath `path:"~"`
+}
+
+// DownloadDocumentInputPath is a type auto-generated from OpenAPI spec
+// It is the path of DownloadDocumentInput
+// stride:generate download-document-input-path
+type DownloadDocumentInputPath struct {
+	// stride:generate document-id
+	DocumentID string `path:"documentId,simple" validate:"required,gte=0"`
+}
+
+// DownloadDocumentOKOutput is a type auto-generated from OpenAPI spec
+// It is the output of DownloadDocument operation with code: 200
+// stride:generate download-document-ok-output
+type DownloadDocumentOKOutput struct {
+	// stride:generate body
+	Body *File `body:"~"`
+}
+
+// WriteTo streams the body to the writer
+// stride:generate download-document-ok-output:write-to
+func (x DownloadDocumentOKOutput) WriteTo(w io.Writer) (int64, error) {
+	if x.Body == nil || x.Body.Reader == nil {
+		return 0, nil
+	}
+
+	return io.Copy(w, x.Body.Reader)
+}
+
+// ContentType returns the content type of the body
+// stride:generate download-document-ok-output:content-type
+func (x DownloadDocumentOKOutput) ContentType() string {
+	if x.Body != nil && x.Body.ContentType != "" {
+		return x.Body.ContentType
+	}
+
+	return "application/octet-stream"
+}
+
+// Status returns an http status code
+// stride:generate download-document-ok-output:status
+func (x *DownloadDocumentOKOutput) Status() int {
+	// stride:define body:start
+	// NOTE: not implemented
+	// stride:define body:end
+	return 200
+}
+
+// DownloadDocumentOutput is a type auto-generated from OpenAPI spec
+// It is the alias to the default output of DownloadDocument operation
+// stride:generate download-document-output
+type DownloadDocumentOutput DownloadDocumentOKOutput
+
+// UploadDocumentInput is a type auto-generated from OpenAPI spec
+// It is the input of UploadDocument operation
+// stride:generate upload-document-input
+type UploadDocumentInput struct {
+	// stride:generate body
+	Body *DocumentUpload `body:"~" form:"~"`
+}
+
+// UnmarshalMultipart unmarshals from a multipart form
+// stride:generate upload-document-input:unmarshal-multipart
+func (x *UploadDocumentInput) UnmarshalMultipart(form *multipart.Form) error {
+	x.Body = &DocumentUpload{}
+	return decodeMultipart(form, x.Body, map[string]string{"file": "application/pdf", "metadata": "application/json"})
+}
+
+// ValidateRequest validates the value of a request and returns the field errors
+// stride:generate upload-document-input:validate-request
+func (x UploadDocumentInput) ValidateRequest() error {
+	errs := ValidationErrors{}
+	if x.Body != nil {
+		errs.Merge("", (*x.Body).ValidateRequest())
+	}
+
+	return errs.Err()
+}
+
+// UploadDocumentCreatedOutput is a type auto-generated from OpenAPI spec
+// It is the output of UploadDocument operation with code: 201
+// stride:generate upload-document-created-output
+type UploadDocumentCreatedOutput struct {
+	// stride:generate body
+	Body *Document `body:"~"`
+}
+
+// MarshalJSON marshals into valid JSON
+// stride:generate upload-document-created-output:marshal-j-s-o-n
+func (x UploadDocumentCreatedOutput) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.Body)
+}
+
+// ValidateResponse validates the value of a response and returns the field errors
+// stride:generate upload-document-created-output:validate-response
+func (x UploadDocumentCreatedOutput) ValidateResponse() error {
+	errs := ValidationErrors{}
+	if x.Body != nil {
+		errs.Merge("", (*x.Body).ValidateResponse())
+	}
+
+	return errs.Err()
+}
+
+// Status returns an http status code
+// stride:generate upload-document-created-output:status
+func (x *UploadDocumentCreatedOutput) Status() int {
+	// stride:define body:start
+	// NOTE: not implemented
+	// stride:define body:end
+	return 201
+}
+
+// UploadDocumentOutput is a type auto-generated from OpenAPI spec
+// It is the alias to the default output of UploadDocument operation
+// stride:generate upload-document-output
+type UploadDocumentOutput UploadDocumentCreatedOutput
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"encoding/json"
+	"encoding/xml"
+)
+
+// CreateNoteInput is a type auto-generated from OpenAPI spec
+// It is the input of CreateNote operation
+// stride:generate create-note-input
+type CreateNoteInput struct {
+	// stride:generate body
+	Body *Note `body:"~" form:"~"`
+}
+
+// UnmarshalJSON unmarshals from valid JSON
+// stride:generate create-note-input:unmarshal-j-s-o-n
+func (x *CreateNoteInput) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &x.Body)
+}
+
+// ValidateRequest validates the value of a request and returns the field errors
+// stride:generate create-note-input:validate-request
+func (x CreateNoteInput) ValidateRequest() error {
+	errs := ValidationErrors{}
+	if x.Body != nil {
+		errs.Merge("", (*x.Body).ValidateRequest())
+	}
+
+	return errs.Err()
+}
+
+// CreateNoteCreatedOutput is a type auto-generated from OpenAPI spec
+// It is the output of CreateNote operation with code: 201
+// stride:generate create-note-created-output
+type CreateNoteCreatedOutput struct {
+	// stride:generate body
+	Body *Note `body:"~"`
+}
+
+// MarshalJSON marshals into valid JSON
+// stride:generate create-note-created-output:marshal-j-s-o-n
+func (x CreateNoteCreatedOutput) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.Body)
+}
+
+// MarshalXML marshals into valid XML
+// stride:generate create-note-created-output:marshal-x-m-l
+func (x CreateNoteCreatedOutput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(x.Body, start)
+}
+
+// ValidateResponse validates the value of a response and returns the field errors
+// stride:generate create-note-created-output:validate-response
+func (x CreateNoteCreatedOutput) ValidateResponse() error {
+	errs := ValidationErrors{}
+	if x.Body != nil {
+		errs.Merge("", (*x.Body).ValidateResponse())
+	}
+
+	return errs.Err()
+}
+
+// Status returns an http status code
+// stride:generate create-note-created-output:status
+func (x *CreateNoteCreatedOutput) Status() int {
+	// stride:define body:start
+	// NOTE: not implemented
+	// stride:define body:end
+	return 201
+}
+
+// CreateNoteOutput is a type auto-generated from OpenAPI spec
+// It is the alias to the default output of CreateNote operation
+// stride:generate create-note-output
+type CreateNoteOutput CreateNoteCreatedOutput
+
+// UpdateNoteTextInput is a type auto-generated from OpenAPI spec
+// It is the input of UpdateNoteText operation
+// stride:generate update-note-text-input
+type UpdateNoteTextInput struct {
+	// stride:generate path
+	Path *UpdateNoteTextInputPath `path:"~"`
+	// stride:generate binary-body
+	BinaryBody *File `body:"~"`
+	// stride:generate text-body
+	TextBody string `body:"~"`
+}
+
+// UpdateNoteTextInputPath is a type auto-generated from OpenAPI spec
+// It is the path of UpdateNoteTextInput
+// stride:generate update-note-text-input-path
+type UpdateNoteTextInputPath struct {
+	// stride:generate note-id
+	NoteID string `path:"noteId,simple" validate:"required,gte=0"`
+}
+
+// UnmarshalFile unmarshals from a binary stream
+// stride:generate update-note-text-input:unmarshal-file
+func (x *UpdateNoteTextInput) UnmarshalFile(file *File) error {
+	return decodeStream(file, &x.BinaryBody)
+}
+
+// UnmarshalText unmarshals from plain text
+// stride:generate update-note-text-input:unmarshal-text
+func (x *UpdateNoteTextInput) UnmarshalText(data []byte) error {
+	return decodeText(data, &x.TextBody)
+}
+
+// UpdateNoteTextNotFoundOutput is a type auto-generated from OpenAPI spec
+// It is the output of UpdateNoteText operation with code: 404
+// stride:generate update-note-text-not-found-output
+type UpdateNoteTextNotFoundOutput struct {
+	// stride:generate body
+	Body string `body:"~"`
+}
+
+// Status returns an http status code
+// stride:generate update-note-text-not-found-output:status
+func (x *UpdateNoteTextNotFoundOutput) Status() int {
+	// stride:define body:start
+	// NOTE: not implemented
+	// stride:define body:end
+	return 404
+}
+
+// UpdateNoteTextOKOutput is a type auto-generated from OpenAPI spec
+// It is the output of UpdateNoteText operation with code: 200
+// stride:generate update-note-text-ok-output
+type UpdateNoteTextOKOutput struct {
+	// stride:generate body
+	Body string `body:"~"`
+}
+
+// MarshalText marshals into plain text
+// stride:generate update-note-text-ok-output:marshal-text
+func (x UpdateNoteTextOKOutput) MarshalText() ([]byte, error) {
+	return encodeText(x.Body)
+}
+
+// Status returns an http status code
+// stride:generate update-note-text-ok-output:status
+func (x *UpdateNoteTextOKOutput) Status() int {
+	// stride:define body:start
+	// NOTE: not implemented
+	// stride:define body:end
+	return 200
+}
+
+// UpdateNoteTextOutput is a type auto-generated from OpenAPI spec
+// It is the alias to the default output of UpdateNoteText operation
+// stride:generate update-note-text-output
+type UpdateNoteTextOutput UpdateNoteTextOKOutput
@@ -0,0 +1,324 @@
+package service
+
+import "unicode/utf8"
+
+// Credential is a type auto-generated from OpenAPI spec
+// stride:generate credential
+type Credential struct {
+	// stride:generate id
+	ID string `json:"id" xml:"id" form:"id" field:"id" validate:"gte=0"`
+	// stride:generate name
+	Name string `json:"name,omitempty" xml:"name,omitempty" form:"name,omitempty" field:"name,omitempty" validate:"gte=0,lte=20"`
+	// stride:generate secret
+	Secret string `json:"secret" xml:"secret" form:"secret" field:"secret" validate:"gte=0"`
+}
+
+// Validate validates the value and returns the field errors
+// stride:generate credential:validate
+func (x Credential) Validate() error {
+	errs := ValidationErrors{}
+	if x.Name != "" {
+		if utf8.RuneCountInString(x.Name) > 20 {
+			errs.Add("name", "must have at most 20 characters")
+		}
+	}
+
+	return errs.Err()
+}
+
+// ValidateRequest validates the value of a request and returns the field errors.
+// The read-only properties must not be set.
+// stride:generate credential:validate-request
+func (x Credential) ValidateRequest() error {
+	errs := ValidationErrors{}
+	if x.ID != "" {
+		errs.Add("id", "is read-only")
+	}
+	if x.Name != "" {
+		if utf8.RuneCountInString(x.Name) > 20 {
+			errs.Add("name", "must have at most 20 characters")
+		}
+	}
+	if x.Secret == "" {
+		errs.Add("secret", "is required")
+	}
+
+	return errs.Err()
+}
+
+// ValidateResponse validates the value of a response and returns the field errors.
+// The write-only properties must not be set.
+// stride:generate credential:validate-response
+func (x Credential) ValidateResponse() error {
+	errs := ValidationErrors{}
+	if x.ID == "" {
+		errs.Add("id", "is required")
+	}
+	if x.Name != "" {
+		if utf8.RuneCountInString(x.Name) > 20 {
+			errs.Add("name", "must have at most 20 characters")
+		}
+	}
+	if x.Secret != "" {
+		errs.Add("secret", "is write-only")
+	}
+
+	return errs.Err()
+}
+
+// Credentials is a type auto-generated from OpenAPI spec
+// stride:generate credentials
+type Credentials []*Credential
+
+// Validate validates the value and returns the field errors
+// stride:generate credentials:validate
+func (x Credentials) Validate() error {
+	errs := ValidationErrors{}
+	for index0, item0 := range x {
+		if item0 != nil {
+			errs.Merge(fieldIndex("", index0), (*item0).Validate())
+		}
+	}
+
+	return errs.Err()
+}
+
+// ValidateRequest validates the value of a request and returns the field errors.
+// The read-only properties must not be set.
+// stride:generate credentials:validate-request
+func (x Credentials) ValidateRequest() error {
+	errs := ValidationErrors{}
+	for index0, item0 := range x {
+		if item0 != nil {
+			errs.Merge(fieldIndex("", index0), (*item0).ValidateRequest())
+		}
+	}
+
+	return errs.Err()
+}
+
+// ValidateResponse validates the value of a response and returns the field errors.
+// The write-only properties must not be set.
+// stride:generate credentials:validate-response
+func (x Credentials) ValidateResponse() error {
+	errs := ValidationErrors{}
+	for index0, item0 := range x {
+		if item0 != nil {
+			errs.Merge(fieldIndex("", index0), (*item0).ValidateResponse())
+		}
+	}
+
+	return errs.Err()
+}
+
+// Document is a type auto-generated from OpenAPI spec
+// stride:generate document
+type Document struct {
+	// stride:generate id
+	ID string `json:"id,omitempty" xml:"id,omitempty" form:"id,omitempty" field:"id,omitempty" validate:"gte=0"`
+	// stride:generate title
+	Title string `json:"title,omitempty" xml:"title,omitempty" form:"title,omitempty" field:"title,omitempty" validate:"gte=0"`
+}
+
+// Validate validates the value and returns the field errors
+// stride:generate document:validate
+func (x Document) Validate() error {
+	errs := ValidationErrors{}
+	return errs.Err()
+}
+
+// ValidateRequest validates the value of a request and returns the field errors
+// stride:generate document:validate-request
+func (x Document) ValidateRequest() error {
+	return x.Validate()
+}
+
+// ValidateResponse validates the value of a response and returns the field errors
+// stride:generate document:validate-response
+func (x Document) ValidateResponse() error {
+	return x.Validate()
+}
+
+// DocumentUpload is a type auto-generated from OpenAPI spec
+// stride:generate document-upload
+type DocumentUpload struct {
+	// stride:generate attachments
+	Attachments DocumentUploadAttachments `json:"attachments,omitempty" xml:"attachments,omitempty" form:"attachments,omitempty" field:"attachments,omitempty" validate:"gte=0"`
+	// stride:generate file
+	File *File `json:"file" xml:"file" form:"file" field:"file" validate:"required"`
+	// stride:generate metadata
+	Metadata *DocumentUploadMetadata `json:"metadata,omitempty" xml:"metadata,omitempty" form:"metadata,omitempty" field:"metadata,omitempty" validate:"-"`
+	// stride:generate tags
+	Tags DocumentUploadTags `json:"tags,omitempty" xml:"tags,omitempty" form:"tags,omitempty" field:"tags,omitempty" validate:"gte=0"`
+}
+
+// Validate validates the value and returns the field errors
+// stride:generate document-upload:validate
+func (x DocumentUpload) Validate() error {
+	errs := ValidationErrors{}
+	if x.Attachments != nil {
+		errs.Merge("attachments", x.Attachments.Validate())
+	}
+	if x.File == nil {
+		errs.Add("file", "is required")
+	}
+	if x.Metadata != nil {
+		errs.Merge("metadata", (*x.Metadata).Validate())
+	}
+	if x.Tags != nil {
+		errs.Merge("tags", x.Tags.Validate())
+	}
+
+	return errs.Err()
+}
+
+// ValidateRequest validates the value of a request and returns the field errors
+// stride:generate document-upload:validate-request
+func (x DocumentUpload) ValidateRequest() error {
+	return x.Validate()
+}
+
+// ValidateResponse validates the value of a response and returns the field errors
+// stride:generate document-upload:validate-response
+func (x DocumentUpload) ValidateResponse() error {
+	return x.Validate()
+}
+
+// DocumentUploadAttachments is a type auto-generated from OpenAPI spec
+// stride:generate document-upload-attachments
+type DocumentUploadAttachments []*File
+
+// Validate validates the value and returns the field errors
+// stride:generate document-upload-attachments:validate
+func (x DocumentUploadAttachments) Validate() error {
+	errs := ValidationErrors{}
+	return errs.Err()
+}
+
+// ValidateRequest validates the value of a request and returns the field errors
+// stride:generate document-upload-attachments:validate-request
+func (x DocumentUploadAttachments) ValidateRequest() error {
+	return x.Validate()
+}
+
+// ValidateResponse validates the value of a response and returns the field errors
+// stride:generate document-upload-attachments:validate-response
+func (x DocumentUploadAttachments) ValidateResponse() error {
+	return x.Validate()
+}
+
+// DocumentUploadMetadata is a type auto-generated from OpenAPI spec
+// stride:generate document-upload-metadata
+type DocumentUploadMetadata struct {
+	// stride:generate title
+	Title string `json:"title,omitempty" xml:"title,omitempty" form:"title,omitempty" field:"title,omitempty" validate:"gte=0"`
+}
+
+// Validate validates the value and returns the field errors
+// stride:generate document-upload-metadata:validate
+func (x DocumentUploadMetadata) Validate() error {
+	errs := ValidationErrors{}
+	return errs.Err()
+}
+
+// ValidateRequest validates the value of a request and returns the field errors
+// stride:generate document-upload-metadata:validate-request
+func (x DocumentUploadMetadata) ValidateRequest() error {
+	return x.Validate()
+}
+
+// ValidateResponse validates the value of a response and returns the field errors
+// stride:generate document-upload-metadata:validate-response
+func (x DocumentUploadMetadata) ValidateResponse() error {
+	return x.Validate()
+}
+
+// DocumentUploadTags is a type auto-generated from OpenAPI spec
+// stride:generate document-upload-tags
+type DocumentUploadTags []string
+
+// Validate validates the value and returns the field errors
+// stride:generate document-upload-tags:validate
+func (x DocumentUploadTags) Validate() error {
+	errs := ValidationErrors{}
+	return errs.Err()
+}
+
+// ValidateRequest validates the value of a request and returns the field errors
+// stride:generate document-upload-tags:validate-request
+func (x DocumentUploadTags) ValidateRequest() error {
+	return x.Validate()
+}
+
+// ValidateResponse validates the value of a response and returns the field errors
+// stride:generate document-upload-tags:validate-response
+func (x DocumentUploadTags) ValidateResponse() error {
+	return x.Validate()
+}
+
+// Note is a type auto-generated from OpenAPI spec
+// stride:generate note
+type Note struct {
+	// stride:generate priority
+	Priority float32 `json:"priority,omitempty" xml:"priority,omitempty" form:"priority,omitempty" field:"priority,omitempty" validate:"multipleof=0.5"`
+	// stride:generate tags
+	Tags NoteTags `json:"tags,omitempty" xml:"tags,omitempty" form:"tags,omitempty" field:"tags,omitempty" validate:"gte=0"`
+	// stride:generate title
+	Title string `json:"title,omitempty" xml:"title,omitempty" form:"title,omitempty" field:"title,omitempty" validate:"gte=0,regexp=^[a-z ]+$"`
+}
+
+// Validate validates the value and returns the field errors
+// stride:generate note:validate
+func (x Note) Validate() error {
+	errs := ValidationErrors{}
+	if x.Priority != 0 {
+		if !multipleOf(float64(x.Priority), 0.5) {
+			errs.Add("priority", "must be a multiple of 0.5")
+		}
+	}
+	if x.Tags != nil {
+		errs.Merge("tags", x.Tags.Validate())
+	}
+	if x.Title != "" {
+		if !matchPattern("^[a-z ]+$", x.Title) {
+			errs.Add("title", "must match the pattern %s", "^[a-z ]+$")
+		}
+	}
+
+	return errs.Err()
+}
+
+// ValidateRequest validates the value of a request and returns the field errors
+// stride:generate note:validate-request
+func (x Note) ValidateRequest() error {
+	return x.Validate()
+}
+
+// ValidateResponse validates the value of a response and returns the field errors
+// stride:generate note:validate-response
+func (x Note) ValidateResponse() error {
+	return x.Validate()
+}
+
+// NoteTags is a type auto-generated from OpenAPI spec
+// stride:generate note-tags
+type NoteTags []string
+
+// Validate validates the value and returns the field errors
+// stride:generate note-tags:validate
+func (x NoteTags) Validate() error {
+	errs := ValidationErrors{}
+	return errs.Err()
+}
+
+// ValidateRequest validates the value of a request and returns the field errors
+// stride:generate note-tags:validate-request
+func (x NoteTags) ValidateRequest() error {
+	return x.Validate()
+}
+
+// ValidateResponse validates the value of a response and returns the field errors
+// stride:generate note-tags:validate-response
+func (x NoteTags) ValidateResponse() error {
+	return x.Validate()
+}
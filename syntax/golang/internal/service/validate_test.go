@@ -0,0 +1,77 @@
+package service
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validate", func() {
+	Context("when the value is in any direction", func() {
+		It("does not require the read-only and write-only properties", func() {
+			Expect((&Credential{Name: "ci"}).Validate()).To(Succeed())
+		})
+
+		It("validates the other properties", func() {
+			err := (&Credential{Name: "a very long credential name"}).Validate()
+			Expect(err).To(MatchError("name: must have at most 20 characters"))
+		})
+	})
+
+	Context("when the value is a request", func() {
+		It("validates the request", func() {
+			Expect((&Credential{Secret: "s3cr3t"}).ValidateRequest()).To(Succeed())
+		})
+
+		It("rejects the read-only properties", func() {
+			err := (&Credential{ID: "c1", Secret: "s3cr3t"}).ValidateRequest()
+			Expect(err).To(MatchError("id: is read-only"))
+		})
+
+		It("requires the write-only properties", func() {
+			err := (&Credential{}).ValidateRequest()
+			Expect(err).To(MatchError("secret: is required"))
+		})
+	})
+
+	Context("when the value is a response", func() {
+		It("validates the response", func() {
+			Expect((&Credential{ID: "c1"}).ValidateResponse()).To(Succeed())
+		})
+
+		It("rejects the write-only properties", func() {
+			err := (&Credential{ID: "c1", Secret: "s3cr3t"}).ValidateResponse()
+			Expect(err).To(MatchError("secret: is write-only"))
+		})
+
+		It("requires the read-only properties", func() {
+			err := (&Credential{}).ValidateResponse()
+			Expect(err).To(MatchError("id: is required"))
+		})
+	})
+
+	Context("when the value is the input of an operation", func() {
+		It("validates the body as a request", func() {
+			input := &CreateCredentialInput{Body: &Credential{ID: "c1", Secret: "s3cr3t"}}
+			Expect(input.ValidateRequest()).To(MatchError("id: is read-only"))
+		})
+	})
+
+	Context("when the value is the output of an operation", func() {
+		It("validates the body as a response", func() {
+			output := &CreateCredentialCreatedOutput{Body: &Credential{ID: "c1", Secret: "s3cr3t"}}
+			Expect(output.ValidateResponse()).To(MatchError("secret: is write-only"))
+		})
+	})
+
+	Context("when the nested values are validated", func() {
+		It("validates them in the same direction", func() {
+			credentials := Credentials{
+				&Credential{Secret: "s3cr3t"},
+				&Credential{ID: "c2", Secret: "s3cr3t"},
+			}
+
+			Expect(credentials.ValidateRequest()).To(MatchError("[1].id: is read-only"))
+			Expect(credentials.ValidateResponse()).To(MatchError("[0].id: is required; [0].secret: is write-only; [1].secret: is write-only"))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"github.com/go-playground/validator/v10"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validation", func() {
+	var validate *validator.Validate
+
+	BeforeEach(func() {
+		validate = NewValidator()
+	})
+
+	It("validates the custom tags", func() {
+		note := &Note{Title: "buy milk", Priority: 1.5}
+		Expect(validate.Struct(note)).To(Succeed())
+	})
+
+	It("does not validate the empty values", func() {
+		Expect(validate.Struct(&Note{})).To(Succeed())
+	})
+
+	Context("when the value does not match the pattern", func() {
+		It("returns an error", func() {
+			err := validate.Struct(&Note{Title: "Buy Milk"})
+			Expect(err).To(HaveOccurred())
+
+			errs, ok := err.(validator.ValidationErrors)
+			Expect(ok).To(BeTrue())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field()).To(Equal("Title"))
+			Expect(errs[0].Tag()).To(Equal("regexp"))
+		})
+	})
+
+	Context("when the value is not a multiple of the factor", func() {
+		It("returns an error", func() {
+			err := validate.Struct(&Note{Priority: 0.75})
+			Expect(err).To(HaveOccurred())
+
+			errs, ok := err.(validator.ValidationErrors)
+			Expect(ok).To(BeTrue())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field()).To(Equal("Priority"))
+			Expect(errs[0].Tag()).To(Equal("multipleof"))
+		})
+	})
+
+	Context("when the custom validations are not registered", func() {
+		It("panics", func() {
+			Expect(func() { validator.New().Struct(&Note{}) }).To(Panic())
+		})
+	})
+})
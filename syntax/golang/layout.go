@@ -0,0 +1,148 @@
+package golang
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/phogolabs/stride/contract"
+)
+
+// the package of the generated files if it's not configured
+const defaultPackage = "service"
+
+// packageOf returns the name of the package or the default one
+func packageOf(name string) string {
+	if name == "" {
+		return defaultPackage
+	}
+
+	return name
+}
+
+// Package represents a generated package
+type Package struct {
+	// Name of the package
+	Name string
+	// Path to the package directory
+	Path string
+	// Import path of the package
+	Import string
+}
+
+// Qualifier returns the prefix of the identifiers declared by the package
+func (p *Package) Qualifier() string {
+	if p == nil || p.Name == "" {
+		return ""
+	}
+
+	return p.Name + "."
+}
+
+// Layout represents the packages of the generated project
+type Layout struct {
+	Models   *Package
+	Handlers *Package
+	Client   *Package
+	Command  *Package
+}
+
+func (g *Generator) layout() (*Layout, error) {
+	var (
+		models   = g.Models
+		handlers = g.Handlers
+		command  = g.Command
+	)
+
+	if models == "" {
+		models = defaultPackage
+	}
+
+	if handlers == "" {
+		handlers = defaultPackage
+	}
+
+	if command == "" {
+		command = filepath.Base(g.Path)
+	}
+
+	project, err := g.project()
+	if err != nil {
+		return nil, err
+	}
+
+	layout := &Layout{}
+
+	if layout.Models, err = g.pkg(project, models); err != nil {
+		return nil, err
+	}
+
+	if layout.Handlers, err = g.pkg(project, handlers); err != nil {
+		return nil, err
+	}
+
+	if layout.Client, err = g.pkg(project, "client"); err != nil {
+		return nil, err
+	}
+
+	if layout.Command, err = g.pkg(project, filepath.Join("cmd", command)); err != nil {
+		return nil, err
+	}
+
+	layout.Command.Name = "main"
+	return layout, nil
+}
+
+func (g *Generator) project() (string, error) {
+	project, err := ImportPath(g.Path, g.Module)
+	if err != nil {
+		return "", err
+	}
+
+	// the module directive of go.mod has precedence
+	if g.Module != "" && g.Module != project {
+		reporter := g.Reporter.With(contract.SeverityHigh)
+		reporter.Warn(" Ignoring module: %s. The project import path is %s", g.Module, project)
+	}
+
+	return project, nil
+}
+
+func (g *Generator) pkg(project, dir string) (*Package, error) {
+	dir = filepath.Clean(filepath.FromSlash(dir))
+
+	// the packages cannot be generated outside of the project
+	if filepath.IsAbs(dir) || dir == "." || strings.HasPrefix(dir, "..") {
+		return nil, fmt.Errorf("invalid package directory: %v. It should be relative to the project directory", dir)
+	}
+
+	pkg := &Package{
+		Name:   packageName(dir),
+		Path:   filepath.Join(g.Path, dir),
+		Import: path.Join(project, filepath.ToSlash(dir)),
+	}
+
+	return pkg, nil
+}
+
+// packageName returns the name of the package in the directory
+func packageName(dir string) string {
+	name := strings.Map(func(char rune) rune {
+		switch {
+		case char >= 'a' && char <= 'z', char == '_':
+			return char
+		case unicode.IsDigit(char) || unicode.IsLetter(char):
+			return unicode.ToLower(char)
+		default:
+			return -1
+		}
+	}, filepath.Base(dir))
+
+	if name == "" || unicode.IsDigit([]rune(name)[0]) {
+		name = "pkg" + name
+	}
+
+	return name
+}
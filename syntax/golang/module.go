@@ -0,0 +1,222 @@
+package golang
+
+import (
+	"bufio"
+	"fmt"
+	"go/build"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/syntax"
+)
+
+// Module represents a Go module
+type Module struct {
+	// Path is the module path declared in go.mod
+	Path string
+	// Dir is the directory that contains go.mod
+	Dir string
+}
+
+// FindModule returns the nearest module that contains the directory. It
+// returns nil if the directory is not part of a module.
+func FindModule(dir string) (*Module, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		name := filepath.Join(dir, "go.mod")
+
+		if info, err := os.Stat(name); err == nil && !info.IsDir() {
+			module, err := ReadModule(name)
+			if err != nil {
+				return nil, err
+			}
+
+			module.Dir = dir
+			return module, nil
+		}
+
+		parent := filepath.Dir(dir)
+
+		if parent == dir {
+			return nil, nil
+		}
+
+		dir = parent
+	}
+}
+
+// ReadModule reads the module directive of a go.mod file
+func ReadModule(name string) (*Module, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if index := strings.Index(line, "//"); index >= 0 {
+			line = strings.TrimSpace(line[:index])
+		}
+
+		fields := strings.Fields(line)
+
+		if len(fields) != 2 || fields[0] != "module" {
+			continue
+		}
+
+		value := fields[1]
+
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+
+		return &Module{Path: value, Dir: filepath.Dir(name)}, nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("missing module directive in: %v", name)
+}
+
+// ImportPath returns the import path of the directory. It's derived from
+// the nearest go.mod. If there is no module, the fallback is used as import
+// path. The path relative to GOPATH/src is used as a last resort.
+func ImportPath(dir, fallback string) (string, error) {
+	module, err := FindModule(dir)
+	if err != nil {
+		return "", err
+	}
+
+	if module != nil {
+		return module.Import(dir)
+	}
+
+	if fallback != "" {
+		return fallback, nil
+	}
+
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	project, err := filepath.Rel(filepath.Join(build.Default.GOPATH, "src"), dir)
+	if err != nil || project == "." || strings.HasPrefix(project, "..") {
+		return "", fmt.Errorf("cannot resolve the import path of: %v. Create a go.mod file or set the module path with --module", dir)
+	}
+
+	return filepath.ToSlash(project), nil
+}
+
+// Import returns the import path of a directory in the module
+func (m *Module) Import(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(m.Dir, dir)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("directory: %v is not part of module: %v", dir, m.Path)
+	}
+
+	return path.Join(m.Path, filepath.ToSlash(rel)), nil
+}
+
+func (g *Generator) scaffold() error {
+	reporter := g.Reporter.With(contract.SeverityHigh)
+
+	module, err := FindModule(g.Path)
+	if err != nil {
+		return err
+	}
+
+	if module != nil {
+		reporter.Info(" Skipping go.mod file. The project is part of module: %s", module.Path)
+		return nil
+	}
+
+	project, err := ImportPath(g.Path, g.Module)
+	if err != nil {
+		return err
+	}
+
+	filename := filepath.Join(g.Path, "go.mod")
+	reporter.Notice(" Generating module file: %s...", filename)
+
+	writer := &syntax.TemplateWriter{
+		Path: "syntax/golang/go.mod.tpl",
+		Context: map[string]interface{}{
+			"module": project,
+		},
+	}
+
+	if err := os.MkdirAll(g.Path, 0755); err != nil {
+		reporter.Error(" Generating module file: %s fail: %v", filename, err)
+		return err
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		reporter.Error(" Generating module file: %s fail: %v", filename, err)
+		return err
+	}
+
+	_, err = writer.WriteTo(file)
+	file.Close()
+
+	if err != nil {
+		reporter.Error(" Generating module file: %s fail: %v", filename, err)
+		return err
+	}
+
+	// the scaffold does not fail without the go command or the network
+	if err := pin(g.Path); err != nil {
+		reporter.Warn(" Pinning module dependencies fail: %v", err)
+		reporter.Warn(" Run 'go mod tidy' in the project directory to require them")
+	}
+
+	reporter.Notice(" Generating module file: %s successful", filename)
+	return nil
+}
+
+// dependencies are the modules of the generated code that do not have a
+// release. They are pinned to the pseudo-version of their latest commit.
+var dependencies = []string{
+	"github.com/phogolabs/restify",
+	"github.com/phogolabs/schema",
+}
+
+func pin(dir string) error {
+	args := []string{"get", "-d"}
+
+	for _, name := range dependencies {
+		args = append(args, name+"@master")
+	}
+
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package golang_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/syntax/golang"
+)
+
+var _ = Describe("Module", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = tmpdir()
+		Expect(os.MkdirAll(filepath.Join(dir, "internal", "api"), 0755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	write := func(content string) {
+		Expect(ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte(content), 0644)).To(Succeed())
+	}
+
+	Describe("FindModule", func() {
+		It("finds the nearest module", func() {
+			write("// the module\nmodule \"example.com/bank\" // bank\n\ngo 1.13\n")
+
+			module, err := golang.FindModule(filepath.Join(dir, "internal", "api"))
+			Expect(err).To(BeNil())
+			Expect(module).NotTo(BeNil())
+			Expect(module.Path).To(Equal("example.com/bank"))
+			Expect(module.Dir).To(Equal(dir))
+		})
+
+		Context("when the module directive is missing", func() {
+			It("returns an error", func() {
+				write("go 1.13\n")
+
+				module, err := golang.FindModule(dir)
+				Expect(err).To(MatchError(ContainSubstring("missing module directive in")))
+				Expect(module).To(BeNil())
+			})
+		})
+	})
+
+	Describe("ImportPath", func() {
+		It("returns the import path derived from go.mod", func() {
+			write("module example.com/bank\n")
+
+			project, err := golang.ImportPath(filepath.Join(dir, "internal", "api"), "example.com/other")
+			Expect(err).To(BeNil())
+			Expect(project).To(Equal("example.com/bank/internal/api"))
+		})
+
+		Context("when the directory is not part of a module", func() {
+			It("returns the fallback", func() {
+				project, err := golang.ImportPath(dir, "example.com/other")
+				Expect(err).To(BeNil())
+				Expect(project).To(Equal("example.com/other"))
+			})
+
+			Context("when the fallback is not set", func() {
+				It("returns an error", func() {
+					project, err := golang.ImportPath(dir, "")
+					Expect(err).To(MatchError(ContainSubstring("cannot resolve the import path of")))
+					Expect(project).To(BeEmpty())
+				})
+			})
+		})
+	})
+})
@@ -1,8 +1,10 @@
 package golang_test
 
 import (
+	"bufio"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
@@ -32,3 +34,36 @@ func tmpdir() string {
 	Expect(os.Remove(dir)).To(Succeed())
 	return dir
 }
+
+// requiresOf returns the versions of the required modules
+func requiresOf(name string) map[string]string {
+	file, err := os.Open(name)
+	Expect(err).To(BeNil())
+
+	defer file.Close()
+
+	var (
+		requires = map[string]string{}
+		scanner  = bufio.NewScanner(file)
+		block    = false
+	)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		switch {
+		case len(fields) == 0:
+		case fields[0] == "require" && len(fields) > 1 && fields[1] == "(":
+			block = true
+		case fields[0] == "require" && len(fields) > 2:
+			requires[fields[1]] = fields[2]
+		case block && fields[0] == ")":
+			block = false
+		case block && len(fields) > 1:
+			requires[fields[0]] = fields[1]
+		}
+	}
+
+	Expect(scanner.Err()).To(BeNil())
+	return requires
+}
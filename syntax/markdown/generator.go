@@ -2,20 +2,25 @@ package markdown
 
 import (
 	"fmt"
-	"go/build"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/phogolabs/stride/codedom"
 	"github.com/phogolabs/stride/contract"
 	"github.com/phogolabs/stride/inflect"
 	"github.com/phogolabs/stride/syntax"
+	"github.com/phogolabs/stride/syntax/golang"
 )
 
 // Generator builds the main
 type Generator struct {
-	Path     string
+	Path string
+	// Module is the import path of the project directory if it's not part of
+	// a module
+	Module   string
 	Reporter contract.Reporter
 }
 
@@ -29,8 +34,7 @@ func (g *Generator) Generate(spec *codedom.SpecDescriptor) error {
 
 	reporter.Notice(" Generating markdown documentation...")
 
-	project, err := filepath.Rel(filepath.Join(build.Default.GOPATH, "src"), g.Path)
-
+	project, err := golang.ImportPath(g.Path, g.Module)
 	if err != nil {
 		reporter.Error(" Generating markdown documentation fail: ", err)
 		return err
@@ -42,6 +46,7 @@ func (g *Generator) Generate(spec *codedom.SpecDescriptor) error {
 		"title":       strings.TrimSpace(spec.Info.Title),
 		"description": strings.TrimSpace(spec.Info.Description),
 		"version":     strings.TrimSpace(spec.Info.Version),
+		"links":       g.links(spec.Controllers),
 	}
 
 	// generate README.md
@@ -54,6 +59,60 @@ func (g *Generator) Generate(spec *codedom.SpecDescriptor) error {
 	return nil
 }
 
+// links returns the navigation between the operations
+func (g *Generator) links(controllers codedom.ControllerDescriptorCollection) []map[string]interface{} {
+	items := []map[string]interface{}{}
+
+	for _, controller := range controllers {
+		for _, operation := range controller.Operations {
+			visited := map[string]bool{}
+
+			for _, response := range operation.Responses {
+				for _, link := range response.Links {
+					key := fmt.Sprintf("%d:%s", response.Code, link.Name)
+
+					// the content types of a response share the links
+					if visited[key] {
+						continue
+					}
+
+					visited[key] = true
+
+					code := strconv.Itoa(response.Code)
+
+					if response.Code < 0 {
+						code = "default"
+					}
+
+					parameters := []string{}
+
+					for name, value := range link.Parameters {
+						parameters = append(parameters, fmt.Sprintf("`%s`: `%s`", name, value))
+					}
+
+					if link.RequestBody != "" {
+						parameters = append(parameters, fmt.Sprintf("body: `%s`", link.RequestBody))
+					}
+
+					sort.Strings(parameters)
+
+					items = append(items, map[string]interface{}{
+						"method":      inflect.UpperCase(operation.Method),
+						"path":        operation.Path,
+						"code":        code,
+						"name":        link.Name,
+						"description": strings.TrimSpace(link.Description),
+						"target":      fmt.Sprintf("%s %s", inflect.UpperCase(link.Operation.Method), link.Operation.Path),
+						"parameters":  strings.Join(parameters, ", "),
+					})
+				}
+			}
+		}
+	}
+
+	return items
+}
+
 func (g *Generator) sync(path string, ctx map[string]interface{}) error {
 	reporter := g.Reporter.With(contract.SeverityHigh)
 
@@ -0,0 +1,195 @@
+package typescript
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/inflect"
+)
+
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// File represents a typescript source file
+type File struct {
+	name   string
+	buffer *bytes.Buffer
+}
+
+// NewFile creates a new file
+func NewFile(name string) *File {
+	return &File{
+		name:   name,
+		buffer: &bytes.Buffer{},
+	}
+}
+
+// Name returns the file name
+func (f *File) Name() string {
+	return f.name
+}
+
+// Printf writes a formatted line
+func (f *File) Printf(format string, args ...interface{}) {
+	fmt.Fprintf(f.buffer, format, args...)
+	fmt.Fprintln(f.buffer)
+}
+
+// Commentf writes a documentation comment with the given indentation
+func (f *File) Commentf(indent, text string) {
+	// the comment cannot be terminated by the text
+	text = strings.Replace(strings.TrimSpace(text), "*/", "*\\/", -1)
+
+	if text == "" {
+		return
+	}
+
+	lines := strings.Split(text, "\n")
+
+	if len(lines) == 1 {
+		f.Printf("%s/** %s */", indent, text)
+		return
+	}
+
+	f.Printf("%s/**", indent)
+
+	for _, line := range lines {
+		f.Printf("%s", strings.TrimRight(indent+" * "+strings.TrimSpace(line), " "))
+	}
+
+	f.Printf("%s */", indent)
+}
+
+// Write writes the content to the file
+func (f *File) Write(data []byte) (int, error) {
+	return f.buffer.Write(data)
+}
+
+// WriteTo writes the content to the writer
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	return bytes.NewReader(f.buffer.Bytes()).WriteTo(w)
+}
+
+// String returns the content
+func (f *File) String() string {
+	return f.buffer.String()
+}
+
+// Sync writes the content to the disk
+func (f *File) Sync() error {
+	if err := os.MkdirAll(filepath.Dir(f.name), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(f.name)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	_, err = f.WriteTo(file)
+	return err
+}
+
+// kind returns the typescript type of the descriptor. The named types are
+// qualified with the given namespace.
+func kind(descriptor *codedom.TypeDescriptor, namespace string) string {
+	var name string
+
+	switch {
+	case descriptor.IsAny:
+		name = "unknown"
+	case descriptor.IsMap:
+		name = fmt.Sprintf("Record<string, %s>", kind(descriptor.Element, namespace))
+	case descriptor.IsPrimitive:
+		switch descriptor.Name {
+		case "int32", "int64", "float32", "float64":
+			name = "number"
+		case "boolean":
+			name = "boolean"
+		default:
+			// the dates, uuids and binaries are transferred as strings
+			name = "string"
+		}
+	default:
+		name = namespace + inflect.Camelize(descriptor.Name)
+	}
+
+	// the classes and unions are nullable, because golang refers to them by pointer
+	if descriptor.IsNullable && !descriptor.IsClass && !descriptor.IsUnion {
+		name = name + " | null"
+	}
+
+	return name
+}
+
+// field returns the name of a property as it is declared by an interface
+func field(name string) string {
+	if identifier.MatchString(name) {
+		return name
+	}
+
+	return strconv.Quote(name)
+}
+
+// accessor returns the expression that reads a property of the value
+func accessor(value, name string, optional bool) string {
+	switch {
+	case identifier.MatchString(name) && optional:
+		return value + "?." + name
+	case identifier.MatchString(name):
+		return value + "." + name
+	case optional:
+		return fmt.Sprintf("%s?.[%q]", value, name)
+	default:
+		return fmt.Sprintf("%s[%q]", value, name)
+	}
+}
+
+// member returns the name of an enum member for the given value
+func member(value interface{}) string {
+	text := fmt.Sprintf("%v", value)
+
+	if _, ok := value.(string); !ok {
+		text = "value-" + strings.NewReplacer("-", "minus-", ".", "-dot-").Replace(literal(value))
+	}
+
+	name := inflect.Camelize(text)
+
+	if char, _ := utf8.DecodeRuneInString(name); !unicode.IsLetter(char) {
+		name = "Value" + name
+	}
+
+	return name
+}
+
+// literal returns the typescript literal of the value
+func literal(value interface{}) string {
+	switch item := value.(type) {
+	case string:
+		return strconv.Quote(item)
+	case float32:
+		return strconv.FormatFloat(float64(item), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(item, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", item)
+	}
+}
+
+// lower returns the name in lower camel case
+func lower(name string) string {
+	name = inflect.Camelize(name)
+
+	char, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToLower(char)) + name[size:]
+}
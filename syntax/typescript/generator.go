@@ -0,0 +1,74 @@
+package typescript
+
+import (
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+)
+
+// FileGenerator is a file generator
+type FileGenerator interface {
+	// Generate generates the file
+	Generate() *File
+}
+
+// Generator generates the typescript source code
+type Generator struct {
+	Path     string
+	Reporter contract.Reporter
+}
+
+// Generate generates the source code
+func (g *Generator) Generate(spec *codedom.SpecDescriptor) error {
+	reporter := g.Reporter.With(contract.SeverityVeryHigh)
+	reporter.Notice(" Generating typescript package...")
+
+	generators := []FileGenerator{
+		&SchemaGenerator{
+			Path:       g.Path,
+			Collection: spec.Types,
+			Reporter:   g.Reporter,
+		},
+		&ClientGenerator{
+			Mode:     ClientGeneratorModeBase,
+			Path:     g.Path,
+			Reporter: g.Reporter,
+		},
+		&ClientGenerator{
+			Mode:        ClientGeneratorModeAPI,
+			Path:        g.Path,
+			Controllers: spec.Controllers,
+			Reporter:    g.Reporter,
+		},
+		&IndexGenerator{
+			Path:     g.Path,
+			Reporter: g.Reporter,
+		},
+	}
+
+	for _, generator := range generators {
+		if err := g.sync(generator); err != nil {
+			reporter.Error(" Generating typescript package fail")
+			return err
+		}
+	}
+
+	reporter.Success(" Generating typescript package complete!")
+	return nil
+}
+
+func (g *Generator) sync(generator FileGenerator) error {
+	reporter := g.Reporter.With(contract.SeverityLow)
+
+	if target := generator.Generate(); target != nil {
+		reporter.Info(" Sync file: %s...", target.Name())
+
+		if err := target.Sync(); err != nil {
+			reporter.Error(" Sync file: %s fail: %v", target.Name(), err)
+			return err
+		}
+
+		reporter.Success(" Sync file: %s successful", target.Name())
+	}
+
+	return nil
+}
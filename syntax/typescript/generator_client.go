@@ -0,0 +1,439 @@
+package typescript
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/inflect"
+	"github.com/phogolabs/stride/syntax"
+)
+
+// ClientGeneratorMode determines the mode of this generator
+type ClientGeneratorMode byte
+
+const (
+	// ClientGeneratorModeBase generates the runtime shared by the clients
+	ClientGeneratorModeBase ClientGeneratorMode = 0
+	// ClientGeneratorModeAPI generates the clients of the controllers
+	ClientGeneratorModeAPI ClientGeneratorMode = 1
+)
+
+// the locations of the parameters and the input fields that hold them
+var locations = []struct {
+	In    string
+	Field string
+}{
+	{In: "path", Field: "path"},
+	{In: "query", Field: "query"},
+	{In: "header", Field: "headers"},
+	{In: "cookie", Field: "cookies"},
+}
+
+// ClientGenerator builds the client
+type ClientGenerator struct {
+	Path        string
+	Mode        ClientGeneratorMode
+	Controllers codedom.ControllerDescriptorCollection
+	Reporter    contract.Reporter
+}
+
+// Generate generates a file
+func (g *ClientGenerator) Generate() *File {
+	switch g.Mode {
+	case ClientGeneratorModeBase:
+		return g.base()
+	case ClientGeneratorModeAPI:
+		return g.api()
+	default:
+		return nil
+	}
+}
+
+func (g *ClientGenerator) base() *File {
+	root := NewFile(filepath.Join(g.Path, "runtime.ts"))
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+	reporter.Notice(" Generating runtime file: %s...", root.Name())
+
+	writer := &syntax.TemplateWriter{
+		Path:    "syntax/typescript/runtime.ts.tpl",
+		Context: map[string]interface{}{},
+	}
+
+	if _, err := writer.WriteTo(root); err != nil {
+		reporter.Error(" Generating runtime file: %s fail: %v", root.Name(), err)
+		return nil
+	}
+
+	reporter.Notice(" Generating runtime file: %s successful", root.Name())
+	return root
+}
+
+func (g *ClientGenerator) api() *File {
+	root := NewFile(filepath.Join(g.Path, "client.ts"))
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+	reporter.Notice(" Generating client file: %s...", root.Name())
+
+	// the type imports are erased by the transpilers that compile every file in isolation
+	root.Printf("import type * as schema from \"./schema\";")
+	root.Printf("import type { ClientOptions } from \"./runtime\";")
+	root.Printf("import { ApiError, decode, send } from \"./runtime\";")
+
+	for _, controller := range g.Controllers {
+		g.controller(root, controller)
+	}
+
+	reporter.Notice(" Generating client file: %s successful", root.Name())
+	return root
+}
+
+func (g *ClientGenerator) controller(root *File, controller *codedom.ControllerDescriptor) {
+	name := inflect.Camelize(controller.Name) + "Client"
+
+	g.Reporter.Info("ﳑ Generating client: %s...", inflect.Dasherize(name))
+
+	for _, operation := range controller.Operations {
+		g.input(root, operation)
+		g.output(root, operation)
+	}
+
+	root.Printf("")
+	root.Commentf("", controller.Description)
+	root.Printf("export class %s {", name)
+	root.Printf("  constructor(private readonly options: ClientOptions) {}")
+
+	for _, operation := range controller.Operations {
+		g.operation(root, operation)
+	}
+
+	root.Printf("}")
+
+	g.Reporter.Success("ﳑ Generating client: %s successful", inflect.Dasherize(name))
+}
+
+func (g *ClientGenerator) input(root *File, operation *codedom.OperationDescriptor) {
+	request := g.request(operation)
+
+	root.Printf("")
+	root.Commentf("", fmt.Sprintf("%sInput is the input of %s operation", inflect.Camelize(operation.Name), lower(operation.Name)))
+	root.Printf("export interface %sInput {", inflect.Camelize(operation.Name))
+
+	for _, location := range locations {
+		parameters := g.parameters(request, location.In)
+
+		if len(parameters) == 0 {
+			continue
+		}
+
+		optional := "?"
+
+		if g.required(parameters) {
+			optional = ""
+		}
+
+		root.Printf("  %s%s: {", location.Field, optional)
+
+		for _, parameter := range parameters {
+			optional := "?"
+
+			if parameter.Required {
+				optional = ""
+			}
+
+			comment := parameter.Description
+
+			if parameter.Deprecated {
+				comment = strings.TrimSpace(comment + "\n@deprecated")
+			}
+
+			root.Commentf("    ", comment)
+			root.Printf("    %s%s: %s;", field(parameter.Name), optional, kind(parameter.ParameterType, "schema."))
+		}
+
+		root.Printf("  };")
+	}
+
+	if request.RequestType != nil {
+		optional := "?"
+
+		if request.Required {
+			optional = ""
+		}
+
+		root.Commentf("  ", request.Description)
+		root.Printf("  body%s: %s;", optional, kind(request.RequestType, "schema."))
+	}
+
+	root.Printf("}")
+}
+
+func (g *ClientGenerator) output(root *File, operation *codedom.OperationDescriptor) {
+	name := inflect.Camelize(operation.Name)
+
+	root.Printf("")
+	root.Commentf("", fmt.Sprintf("%sResponse is the response of %s operation", name, lower(operation.Name)))
+
+	responses := g.responses(operation)
+
+	if len(responses) == 0 {
+		root.Printf("export type %sResponse = never;", name)
+		return
+	}
+
+	root.Printf("export type %sResponse =", name)
+
+	for index, response := range responses {
+		var (
+			status = "number"
+			body   = ""
+			end    = ""
+		)
+
+		if response.Code >= 0 {
+			status = fmt.Sprintf("%d", response.Code)
+		}
+
+		if len(response.Bodies) > 0 {
+			body = fmt.Sprintf("; body: %s", strings.Join(response.Bodies, " | "))
+		}
+
+		if index == len(responses)-1 {
+			end = ";"
+		}
+
+		root.Printf("  | { status: %s; headers: Headers%s }%s", status, body, end)
+	}
+}
+
+func (g *ClientGenerator) operation(root *File, operation *codedom.OperationDescriptor) {
+	var (
+		name     = inflect.Camelize(operation.Name)
+		request  = g.request(operation)
+		accept   = []string{}
+		fallback = false
+	)
+
+	g.Reporter.Info("ﳑ Generating client operation: %s...", inflect.Dasherize(operation.Name))
+
+	if len(operation.Requests) > 1 {
+		reporter := g.Reporter.With(contract.SeverityLow)
+		reporter.Warn("ﳑ Generating client operation: %s uses request content-type: %s. More than one request per operation is not supported",
+			inflect.Dasherize(operation.Name),
+			inflect.Dasherize(request.ContentType),
+		)
+	}
+
+	for _, response := range operation.Responses {
+		if kind := response.ContentType; response.ResponseType != nil && !g.contains(accept, kind) {
+			accept = append(accept, kind)
+		}
+	}
+
+	comment := []string{
+		strings.TrimSpace(operation.Summary),
+		strings.TrimSpace(operation.Description),
+	}
+
+	if operation.Deprecated {
+		comment = append(comment, "@deprecated The operation is obsolete")
+	}
+
+	root.Printf("")
+	root.Commentf("  ", strings.Join(g.compact(comment), "\n\n"))
+
+	if g.requires(request) {
+		root.Printf("  async %s(input: %sInput): Promise<%sResponse> {", lower(operation.Name), name, name)
+	} else {
+		root.Printf("  async %s(input: %sInput = {}): Promise<%sResponse> {", lower(operation.Name), name, name)
+	}
+
+	root.Printf("    const response = await send(this.options, {")
+	root.Printf("      method: %q,", strings.ToUpper(operation.Method))
+	root.Printf("      path: %q,", operation.Path)
+
+	if len(request.Parameters) == 0 {
+		root.Printf("      parameters: [],")
+	} else {
+		root.Printf("      parameters: [")
+	}
+
+	for _, location := range locations {
+		var (
+			parameters = g.parameters(request, location.In)
+			group      = accessor("input", location.Field, false)
+			optional   = !g.required(parameters)
+		)
+
+		for _, parameter := range parameters {
+			root.Printf("        { name: %q, in: %q, style: %q, explode: %t, value: %s },",
+				parameter.Name,
+				location.In,
+				parameter.Style,
+				parameter.Explode,
+				accessor(group, parameter.Name, optional),
+			)
+		}
+	}
+
+	if len(request.Parameters) > 0 {
+		root.Printf("      ],")
+	}
+
+	if request.RequestType != nil {
+		root.Printf("      contentType: %q,", request.ContentType)
+		root.Printf("      body: %s,", accessor("input", "body", false))
+	}
+
+	for index, kind := range accept {
+		accept[index] = fmt.Sprintf("%q", kind)
+	}
+
+	root.Printf("      accept: [%s],", strings.Join(accept, ", "))
+	root.Printf("    });")
+	root.Printf("")
+	root.Printf("    switch (response.status) {")
+
+	for _, response := range g.responses(operation) {
+		status := fmt.Sprintf("%d", response.Code)
+
+		if response.Code < 0 {
+			fallback = true
+			status = "response.status"
+			root.Printf("      default:")
+		} else {
+			root.Printf("      case %d:", response.Code)
+		}
+
+		if len(response.Bodies) > 0 {
+			root.Printf("        return { status: %s, headers: response.headers, body: (await decode(response)) as %s };",
+				status,
+				strings.Join(response.Bodies, " | "),
+			)
+		} else {
+			root.Printf("        return { status: %s, headers: response.headers };", status)
+		}
+	}
+
+	if !fallback {
+		root.Printf("      default:")
+		root.Printf("        throw new ApiError(response.status, response.headers, await decode(response));")
+	}
+
+	root.Printf("    }")
+	root.Printf("  }")
+
+	g.Reporter.Success("ﳑ Generating client operation: %s successful", inflect.Dasherize(operation.Name))
+}
+
+type response struct {
+	Code   int
+	Bodies []string
+}
+
+func (g *ClientGenerator) responses(operation *codedom.OperationDescriptor) []*response {
+	var (
+		codes     = map[int]*response{}
+		responses = []*response{}
+	)
+
+	for _, descriptor := range operation.Responses {
+		item, ok := codes[descriptor.Code]
+
+		// the responses with the same code share the status
+		if !ok {
+			item = &response{Code: descriptor.Code}
+			codes[descriptor.Code] = item
+			responses = append(responses, item)
+		}
+
+		if descriptor.ResponseType == nil {
+			continue
+		}
+
+		if body := kind(descriptor.ResponseType, "schema."); !g.contains(item.Bodies, body) {
+			item.Bodies = append(item.Bodies, body)
+		}
+	}
+
+	// the default response is the last case
+	sort.SliceStable(responses, func(i, j int) bool {
+		var (
+			left  = responses[i].Code
+			right = responses[j].Code
+		)
+
+		if left < 0 || right < 0 {
+			return right < 0 && left >= 0
+		}
+
+		return left < right
+	})
+
+	return responses
+}
+
+func (g *ClientGenerator) request(operation *codedom.OperationDescriptor) *codedom.RequestDescriptor {
+	if len(operation.Requests) > 0 {
+		return operation.Requests[0]
+	}
+
+	return &codedom.RequestDescriptor{}
+}
+
+func (g *ClientGenerator) parameters(request *codedom.RequestDescriptor, in string) codedom.ParameterDescriptorCollection {
+	parameters := codedom.ParameterDescriptorCollection{}
+
+	for _, parameter := range request.Parameters {
+		if strings.EqualFold(parameter.In, in) {
+			parameters = append(parameters, parameter)
+		}
+	}
+
+	return parameters
+}
+
+func (g *ClientGenerator) required(parameters codedom.ParameterDescriptorCollection) bool {
+	for _, parameter := range parameters {
+		if parameter.Required {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requires returns true if the input of the operation cannot be omitted
+func (g *ClientGenerator) requires(request *codedom.RequestDescriptor) bool {
+	if request.RequestType != nil && request.Required {
+		return true
+	}
+
+	return g.required(request.Parameters)
+}
+
+func (g *ClientGenerator) compact(items []string) []string {
+	values := []string{}
+
+	for _, item := range items {
+		if item != "" {
+			values = append(values, item)
+		}
+	}
+
+	return values
+}
+
+func (g *ClientGenerator) contains(items []string, item string) bool {
+	for _, value := range items {
+		if strings.EqualFold(value, item) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,180 @@
+package typescript_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/syntax/typescript"
+)
+
+var _ = Describe("ClientGenerator", func() {
+	var generator *typescript.ClientGenerator
+
+	BeforeEach(func() {
+		reporter := &fake.Reporter{}
+		reporter.WithReturns(reporter)
+
+		generator = &typescript.ClientGenerator{
+			Path:     "/tmp",
+			Mode:     typescript.ClientGeneratorModeAPI,
+			Reporter: reporter,
+			Controllers: codedom.ControllerDescriptorCollection{
+				&codedom.ControllerDescriptor{
+					Name:        "user",
+					Description: "It manages the users",
+					Operations: codedom.OperationDescriptorCollection{
+						&codedom.OperationDescriptor{
+							Method:  "get",
+							Path:    "/users/{user-id}",
+							Name:    "get-user",
+							Summary: "Returns a user",
+							Requests: codedom.RequestDescriptorCollection{
+								&codedom.RequestDescriptor{
+									Parameters: codedom.ParameterDescriptorCollection{
+										&codedom.ParameterDescriptor{
+											Name:     "user-id",
+											In:       "path",
+											Style:    "simple",
+											Required: true,
+											ParameterType: &codedom.TypeDescriptor{
+												Name:        "uuid",
+												IsPrimitive: true,
+											},
+										},
+										&codedom.ParameterDescriptor{
+											Name:    "fields",
+											In:      "query",
+											Style:   "form",
+											Explode: true,
+											ParameterType: &codedom.TypeDescriptor{
+												Name:    "get-user-fields",
+												IsArray: true,
+												Element: &codedom.TypeDescriptor{
+													Name:        "string",
+													IsPrimitive: true,
+												},
+											},
+										},
+									},
+								},
+							},
+							Responses: codedom.ResponseDescriptorCollection{
+								&codedom.ResponseDescriptor{
+									Code:        -1,
+									ContentType: "application/json",
+									ResponseType: &codedom.TypeDescriptor{
+										Name:       "error",
+										IsClass:    true,
+										IsNullable: true,
+									},
+								},
+								&codedom.ResponseDescriptor{
+									Code:        200,
+									ContentType: "application/json",
+									ResponseType: &codedom.TypeDescriptor{
+										Name:       "user",
+										IsClass:    true,
+										IsNullable: true,
+									},
+								},
+								&codedom.ResponseDescriptor{
+									Code: 404,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	It("generates the client", func() {
+		file := generator.Generate()
+		Expect(file).NotTo(BeNil())
+		Expect(file.Name()).To(Equal(filepath.Join("/tmp", "client.ts")))
+
+		source := file.String()
+		Expect(source).To(ContainSubstring("import type * as schema from \"./schema\";"))
+		Expect(source).To(ContainSubstring(`export interface GetUserInput {
+  path: {
+    "user-id": string;
+  };
+  query?: {
+    fields?: schema.GetUserFields;
+  };
+}`))
+		Expect(source).To(ContainSubstring(`export type GetUserResponse =
+  | { status: 200; headers: Headers; body: schema.User }
+  | { status: 404; headers: Headers }
+  | { status: number; headers: Headers; body: schema.Error };`))
+		Expect(source).To(ContainSubstring("/** It manages the users */\nexport class UserClient {"))
+		Expect(source).To(ContainSubstring("  /** Returns a user */\n  async getUser(input: GetUserInput): Promise<GetUserResponse> {"))
+		Expect(source).To(ContainSubstring(`      method: "GET",`))
+		Expect(source).To(ContainSubstring(`        { name: "user-id", in: "path", style: "simple", explode: false, value: input.path["user-id"] },`))
+		Expect(source).To(ContainSubstring(`        { name: "fields", in: "query", style: "form", explode: true, value: input.query?.fields },`))
+		Expect(source).To(ContainSubstring(`      accept: ["application/json"],`))
+		Expect(source).To(ContainSubstring(`      case 404:
+        return { status: 404, headers: response.headers };
+      default:
+        return { status: response.status, headers: response.headers, body: (await decode(response)) as schema.Error };`))
+		Expect(source).NotTo(ContainSubstring("throw new ApiError"))
+	})
+
+	Context("when the operation does not have a default response", func() {
+		BeforeEach(func() {
+			operation := generator.Controllers[0].Operations[0]
+			operation.Responses = operation.Responses[1:]
+		})
+
+		It("throws an error for the unknown status codes", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+			Expect(file.String()).To(ContainSubstring("throw new ApiError(response.status, response.headers, await decode(response));"))
+		})
+	})
+
+	Context("when the operation has a request body", func() {
+		BeforeEach(func() {
+			operation := generator.Controllers[0].Operations[0]
+			operation.Deprecated = true
+			operation.Requests[0] = &codedom.RequestDescriptor{
+				ContentType: "application/json",
+				RequestType: &codedom.TypeDescriptor{
+					Name:       "user",
+					IsClass:    true,
+					IsNullable: true,
+				},
+			}
+		})
+
+		It("sends the body", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+
+			source := file.String()
+			Expect(source).To(ContainSubstring("  body?: schema.User;"))
+			Expect(source).To(ContainSubstring("   * @deprecated The operation is obsolete"))
+			Expect(source).To(ContainSubstring("  async getUser(input: GetUserInput = {}): Promise<GetUserResponse> {"))
+			Expect(source).To(ContainSubstring("      parameters: [],"))
+			Expect(source).To(ContainSubstring(`      contentType: "application/json",`))
+			Expect(source).To(ContainSubstring("      body: input.body,"))
+		})
+	})
+
+	Context("when the mode is base", func() {
+		BeforeEach(func() {
+			generator.Mode = typescript.ClientGeneratorModeBase
+		})
+
+		It("generates the runtime", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+			Expect(file.Name()).To(Equal(filepath.Join("/tmp", "runtime.ts")))
+			Expect(file.String()).To(ContainSubstring("export async function send(options: ClientOptions, request: Request): Promise<Response> {"))
+		})
+	})
+})
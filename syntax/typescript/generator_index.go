@@ -0,0 +1,30 @@
+package typescript
+
+import (
+	"path/filepath"
+
+	"github.com/phogolabs/stride/contract"
+)
+
+// IndexGenerator builds the entry point of the package
+type IndexGenerator struct {
+	Path     string
+	Reporter contract.Reporter
+}
+
+// Generate generates a file
+func (g *IndexGenerator) Generate() *File {
+	root := NewFile(filepath.Join(g.Path, "index.ts"))
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+	reporter.Notice(" Generating index file: %s...", root.Name())
+
+	// the schema is exported as a namespace, because its types may collide
+	// with the names of the client
+	root.Printf("export * from \"./client\";")
+	root.Printf("export * from \"./runtime\";")
+	root.Printf("export * as schema from \"./schema\";")
+
+	reporter.Notice(" Generating index file: %s successful", root.Name())
+	return root
+}
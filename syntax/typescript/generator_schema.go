@@ -0,0 +1,167 @@
+package typescript
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/contract"
+	"github.com/phogolabs/stride/inflect"
+)
+
+// SchemaGenerator builds the schema file
+type SchemaGenerator struct {
+	Path       string
+	Collection codedom.TypeDescriptorCollection
+	Reporter   contract.Reporter
+}
+
+// Generate generates a file
+func (g *SchemaGenerator) Generate() *File {
+	root := NewFile(filepath.Join(g.Path, "schema.ts"))
+
+	reporter := g.Reporter.With(contract.SeverityHigh)
+	reporter.Notice(" Generating schema file: %s...", root.Name())
+
+	for index, descriptor := range g.Collection {
+		if index > 0 {
+			root.Printf("")
+		}
+
+		g.Reporter.Info("ﳑ Generating type: %s...", inflect.Dasherize(descriptor.Name))
+
+		switch {
+		case descriptor.IsAlias:
+			g.alias(root, descriptor)
+		case descriptor.IsArray:
+			g.array(root, descriptor)
+		case descriptor.IsMap:
+			g.dictionary(root, descriptor)
+		case descriptor.IsEnum:
+			g.enum(root, descriptor)
+		case descriptor.IsUnion:
+			g.union(root, descriptor)
+		case descriptor.IsClass:
+			g.class(root, descriptor)
+		}
+
+		g.Reporter.Success("ﳑ Generating type: %s successful", inflect.Dasherize(descriptor.Name))
+	}
+
+	reporter.Notice(" Generating schema file: %s successful", root.Name())
+	return root
+}
+
+func (g *SchemaGenerator) alias(root *File, descriptor *codedom.TypeDescriptor) {
+	root.Commentf("", descriptor.Description)
+	root.Printf("export type %s = %s;", inflect.Camelize(descriptor.Name), kind(descriptor.Element, ""))
+}
+
+func (g *SchemaGenerator) array(root *File, descriptor *codedom.TypeDescriptor) {
+	root.Commentf("", descriptor.Description)
+	root.Printf("export type %s = Array<%s>;", inflect.Camelize(descriptor.Name), kind(descriptor.Element, ""))
+}
+
+func (g *SchemaGenerator) dictionary(root *File, descriptor *codedom.TypeDescriptor) {
+	root.Commentf("", descriptor.Description)
+	root.Printf("export type %s = Record<string, %s>;", inflect.Camelize(descriptor.Name), kind(descriptor.Element, ""))
+}
+
+func (g *SchemaGenerator) enum(root *File, descriptor *codedom.TypeDescriptor) {
+	root.Commentf("", descriptor.Description)
+	root.Printf("export enum %s {", inflect.Camelize(descriptor.Name))
+
+	if values, ok := descriptor.Metadata["values"].([]interface{}); ok {
+		for _, value := range values {
+			if value == nil {
+				continue
+			}
+
+			root.Printf("  %s = %s,", member(value), literal(value))
+		}
+	}
+
+	root.Printf("}")
+}
+
+func (g *SchemaGenerator) union(root *File, descriptor *codedom.TypeDescriptor) {
+	variants := []string{}
+
+	for _, property := range descriptor.Properties {
+		variants = append(variants, kind(property.PropertyType, ""))
+	}
+
+	if len(variants) == 0 {
+		variants = append(variants, "unknown")
+	}
+
+	root.Commentf("", descriptor.Description)
+	root.Printf("export type %s = %s;", inflect.Camelize(descriptor.Name), strings.Join(variants, " | "))
+}
+
+func (g *SchemaGenerator) class(root *File, descriptor *codedom.TypeDescriptor) {
+	var (
+		name    = inflect.Camelize(descriptor.Name)
+		extends = []string{}
+		index   = ""
+		fields  = 0
+	)
+
+	for _, property := range descriptor.Properties {
+		switch {
+		case !property.IsEmbedded:
+			fields++
+		case !property.PropertyType.IsMap:
+			extends = append(extends, inflect.Camelize(property.PropertyType.Name))
+		}
+	}
+
+	for _, property := range descriptor.Properties {
+		if !property.IsEmbedded || !property.PropertyType.IsMap {
+			continue
+		}
+
+		index = kind(property.PropertyType.Element, "")
+
+		// the additional properties cannot restrict the type of the declared ones
+		if fields > 0 || len(extends) > 0 {
+			index = "unknown"
+		}
+	}
+
+	root.Commentf("", descriptor.Description)
+
+	if len(extends) > 0 {
+		root.Printf("export interface %s extends %s {", name, strings.Join(extends, ", "))
+	} else {
+		root.Printf("export interface %s {", name)
+	}
+
+	for _, property := range descriptor.Properties {
+		if property.IsEmbedded {
+			continue
+		}
+
+		var (
+			modifier = ""
+			optional = ""
+		)
+
+		if property.ReadOnly {
+			modifier = "readonly "
+		}
+
+		if !property.Required {
+			optional = "?"
+		}
+
+		root.Commentf("  ", property.Description)
+		root.Printf("  %s%s%s: %s;", modifier, field(property.Name), optional, kind(property.PropertyType, ""))
+	}
+
+	if index != "" {
+		root.Printf("  [key: string]: %s;", index)
+	}
+
+	root.Printf("}")
+}
@@ -0,0 +1,254 @@
+package typescript_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/syntax/typescript"
+)
+
+var _ = Describe("SchemaGenerator", func() {
+	var generator *typescript.SchemaGenerator
+
+	BeforeEach(func() {
+		reporter := &fake.Reporter{}
+		reporter.WithReturns(reporter)
+
+		generator = &typescript.SchemaGenerator{
+			Path:     "/tmp",
+			Reporter: reporter,
+		}
+	})
+
+	It("generates the schema file", func() {
+		file := generator.Generate()
+		Expect(file).NotTo(BeNil())
+		Expect(file.Name()).To(Equal(filepath.Join("/tmp", "schema.ts")))
+	})
+
+	Context("when the type is class", func() {
+		BeforeEach(func() {
+			generator.Collection = codedom.TypeDescriptorCollection{
+				&codedom.TypeDescriptor{
+					Name:        "account",
+					Description: "It is an account",
+					IsClass:     true,
+					IsNullable:  true,
+					Properties: codedom.PropertyDescriptorCollection{
+						&codedom.PropertyDescriptor{
+							Name:       "base",
+							IsEmbedded: true,
+							PropertyType: &codedom.TypeDescriptor{
+								Name:       "base",
+								IsClass:    true,
+								IsNullable: true,
+							},
+						},
+						&codedom.PropertyDescriptor{
+							Name:     "id",
+							Required: true,
+							ReadOnly: true,
+							PropertyType: &codedom.TypeDescriptor{
+								Name:        "uuid",
+								IsPrimitive: true,
+							},
+						},
+						&codedom.PropertyDescriptor{
+							Name:        "first-name",
+							Description: "It is the first name",
+							PropertyType: &codedom.TypeDescriptor{
+								Name:        "string",
+								IsPrimitive: true,
+								IsNullable:  true,
+							},
+						},
+						&codedom.PropertyDescriptor{
+							Name: "labels",
+							PropertyType: &codedom.TypeDescriptor{
+								Name:  "labels",
+								IsMap: true,
+								Key: &codedom.TypeDescriptor{
+									Name:        "string",
+									IsPrimitive: true,
+								},
+								Element: &codedom.TypeDescriptor{
+									Name:        "int64",
+									IsPrimitive: true,
+								},
+							},
+						},
+						&codedom.PropertyDescriptor{
+							Name: "owner",
+							PropertyType: &codedom.TypeDescriptor{
+								Name:       "user",
+								IsClass:    true,
+								IsNullable: true,
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("generates an interface", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+			Expect(file.String()).To(Equal(`/** It is an account */
+export interface Account extends Base {
+  readonly id: string;
+  /** It is the first name */
+  "first-name"?: string | null;
+  labels?: Record<string, number>;
+  owner?: User;
+}
+`))
+		})
+
+		Context("when the class has additional properties only", func() {
+			BeforeEach(func() {
+				generator.Collection[0].Properties = codedom.PropertyDescriptorCollection{
+					&codedom.PropertyDescriptor{
+						Name:       "properties",
+						IsEmbedded: true,
+						PropertyType: &codedom.TypeDescriptor{
+							IsMap: true,
+							Key: &codedom.TypeDescriptor{
+								Name:        "string",
+								IsPrimitive: true,
+							},
+							Element: &codedom.TypeDescriptor{
+								Name:        "float64",
+								IsPrimitive: true,
+							},
+						},
+					},
+				}
+			})
+
+			It("generates an index signature of the element type", func() {
+				file := generator.Generate()
+				Expect(file).NotTo(BeNil())
+				Expect(file.String()).To(ContainSubstring("  [key: string]: number;\n"))
+			})
+		})
+	})
+
+	Context("when the type is enum", func() {
+		BeforeEach(func() {
+			generator.Collection = codedom.TypeDescriptorCollection{
+				&codedom.TypeDescriptor{
+					Name:   "priority",
+					IsEnum: true,
+					Element: &codedom.TypeDescriptor{
+						Name:        "float64",
+						IsPrimitive: true,
+					},
+					Metadata: codedom.Metadata{
+						"values": []interface{}{-1.5, 0.0, 2.0},
+					},
+				},
+				&codedom.TypeDescriptor{
+					Name:   "status",
+					IsEnum: true,
+					Element: &codedom.TypeDescriptor{
+						Name:        "string",
+						IsPrimitive: true,
+					},
+					Metadata: codedom.Metadata{
+						"values": []interface{}{"pending", "in_progress", "2nd"},
+					},
+				},
+			}
+		})
+
+		It("generates an enum", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+			Expect(file.String()).To(Equal(`export enum Priority {
+  ValueMinus1Dot5 = -1.5,
+  Value0 = 0,
+  Value2 = 2,
+}
+
+export enum Status {
+  Pending = "pending",
+  InProgress = "in_progress",
+  Value2nd = "2nd",
+}
+`))
+		})
+	})
+
+	Context("when the type is union", func() {
+		BeforeEach(func() {
+			generator.Collection = codedom.TypeDescriptorCollection{
+				&codedom.TypeDescriptor{
+					Name:       "payment",
+					IsUnion:    true,
+					IsNullable: true,
+					Properties: codedom.PropertyDescriptorCollection{
+						&codedom.PropertyDescriptor{
+							Name: "bank",
+							PropertyType: &codedom.TypeDescriptor{
+								Name:       "bank-payment",
+								IsClass:    true,
+								IsNullable: true,
+							},
+						},
+						&codedom.PropertyDescriptor{
+							Name: "card",
+							PropertyType: &codedom.TypeDescriptor{
+								Name:       "card-payment",
+								IsClass:    true,
+								IsNullable: true,
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("generates a union type", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+			Expect(file.String()).To(Equal("export type Payment = BankPayment | CardPayment;\n"))
+		})
+	})
+
+	Context("when the type is alias", func() {
+		BeforeEach(func() {
+			generator.Collection = codedom.TypeDescriptorCollection{
+				&codedom.TypeDescriptor{
+					Name:    "created-at",
+					IsAlias: true,
+					Element: &codedom.TypeDescriptor{
+						Name:        "date-time",
+						IsPrimitive: true,
+						IsNullable:  true,
+					},
+				},
+				&codedom.TypeDescriptor{
+					Name:    "names",
+					IsArray: true,
+					Element: &codedom.TypeDescriptor{
+						Name:        "string",
+						IsPrimitive: true,
+					},
+				},
+			}
+		})
+
+		It("generates a type alias", func() {
+			file := generator.Generate()
+			Expect(file).NotTo(BeNil())
+			Expect(file.String()).To(Equal(`export type CreatedAt = string | null;
+
+export type Names = Array<string>;
+`))
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package typescript_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/stride/codedom"
+	"github.com/phogolabs/stride/fake"
+	"github.com/phogolabs/stride/syntax/typescript"
+)
+
+var _ = Describe("Generator", func() {
+	var (
+		generator *typescript.Generator
+		spec      *codedom.SpecDescriptor
+	)
+
+	BeforeEach(func() {
+		reporter := &fake.Reporter{}
+		reporter.WithReturns(reporter)
+
+		spec = &codedom.SpecDescriptor{
+			Types: codedom.TypeDescriptorCollection{
+				&codedom.TypeDescriptor{
+					Name:    "account",
+					IsClass: true,
+				},
+			},
+			Controllers: codedom.ControllerDescriptorCollection{
+				&codedom.ControllerDescriptor{
+					Name: "account",
+					Operations: codedom.OperationDescriptorCollection{
+						&codedom.OperationDescriptor{
+							Method: "GET",
+							Path:   "/accounts",
+							Name:   "get-accounts",
+						},
+					},
+				},
+			},
+		}
+
+		generator = &typescript.Generator{
+			Path:     tmpdir(),
+			Reporter: reporter,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(generator.Path)).To(Succeed())
+	})
+
+	It("generates the package", func() {
+		Expect(generator.Generate(spec)).To(Succeed())
+
+		for _, name := range []string{"schema.ts", "runtime.ts", "client.ts", "index.ts"} {
+			Expect(filepath.Join(generator.Path, name)).To(BeARegularFile())
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(generator.Path, "index.ts"))
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring("export * as schema from \"./schema\";"))
+	})
+
+	Context("when cannot create the directory", func() {
+		BeforeEach(func() {
+			generator.Path = "/proc/stride"
+		})
+
+		It("returns an error", func() {
+			Expect(generator.Generate(spec)).To(MatchError("mkdir /proc/stride: no such file or directory"))
+		})
+	})
+})
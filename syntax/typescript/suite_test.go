@@ -0,0 +1,29 @@
+package typescript_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/phogolabs/parcello"
+)
+
+func TestTypeScript(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "TypeScript Suite")
+}
+
+var _ = BeforeSuite(func() {
+	// the templates are read from the source tree
+	parcello.Manager = parcello.Dir("../../template")
+})
+
+func tmpdir() string {
+	dir, err := ioutil.TempDir("", "example")
+	Expect(err).To(BeNil())
+	Expect(os.Remove(dir)).To(Succeed())
+	return dir
+}
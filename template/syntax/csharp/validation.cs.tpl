@@ -0,0 +1,73 @@
+using System.Collections;
+using System.ComponentModel.DataAnnotations;
+using System.Text.Json;
+
+namespace {{ .namespace }}.Models;
+
+/// <summary>
+/// Validates that a number is a multiple of the factor
+/// </summary>
+[AttributeUsage(AttributeTargets.Property | AttributeTargets.Field | AttributeTargets.Parameter)]
+public sealed class MultipleOfAttribute : ValidationAttribute
+{
+    public MultipleOfAttribute(double factor)
+    {
+        Factor = factor;
+    }
+
+    /// <summary>
+    /// The factor of the valid numbers
+    /// </summary>
+    public double Factor { get; }
+
+    public override bool IsValid(object? value)
+    {
+        // the presence is validated by the required attribute
+        if (value is null)
+        {
+            return true;
+        }
+
+        const double epsilon = 1e-9;
+
+        var remainder = Math.Abs(Convert.ToDouble(value) % Factor);
+        return remainder < epsilon || Math.Abs(remainder - Math.Abs(Factor)) < epsilon;
+    }
+
+    public override string FormatErrorMessage(string name)
+    {
+        return $"The field {name} must be a multiple of {Factor}.";
+    }
+}
+
+/// <summary>
+/// Validates that the items of a collection are unique
+/// </summary>
+[AttributeUsage(AttributeTargets.Property | AttributeTargets.Field | AttributeTargets.Parameter)]
+public sealed class UniqueItemsAttribute : ValidationAttribute
+{
+    public override bool IsValid(object? value)
+    {
+        if (value is not IEnumerable items)
+        {
+            return true;
+        }
+
+        var keys = new HashSet<string>();
+
+        foreach (var item in items)
+        {
+            if (!keys.Add(JsonSerializer.Serialize(item)))
+            {
+                return false;
+            }
+        }
+
+        return true;
+    }
+
+    public override string FormatErrorMessage(string name)
+    {
+        return $"The field {name} must have unique items.";
+    }
+}
@@ -0,0 +1,1047 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi"
+)
+
+// Doer performs an http request
+// stride:generate doer
+type Doer interface {
+	Do(r *http.Request) (*http.Response, error)
+}
+
+// Client is the http client shared by the API clients
+// stride:generate client
+type Client struct {
+	// stride:generate url
+	URL string
+	// stride:generate doer
+	Doer Doer
+}
+
+// New creates a new client for the given server url
+// stride:generate new
+func New(url string) *Client {
+	return &Client{
+		URL:  url,
+		Doer: http.DefaultClient,
+	}
+}
+
+// ResponseError is returned when the response status code is not defined by the operation
+// stride:generate response-error
+type ResponseError struct {
+	// stride:generate status-code
+	StatusCode int
+	// stride:generate body
+	Body []byte
+}
+
+// Error returns the error message
+// stride:generate response-error:error
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("unexpected response status code: %d", e.StatusCode)
+}
+
+// stride:generate do
+func (c *Client) do(ctx context.Context, r *request) (*http.Response, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	var (
+		address = c.URL
+		query   string
+	)
+
+	// the path of the operation is joined before the query of the server url
+	if index := strings.Index(address, "?"); index >= 0 {
+		address, query = address[:index], address[index:]
+	}
+
+	address = strings.TrimSuffix(address, "/") + r.path + query
+
+	// the callbacks are sent to the url of the subscriber
+	if r.url != "" {
+		address = r.url
+	}
+
+	uri, err := url.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the query of the url such as the token of a callback url is kept
+	if len(r.query) > 0 {
+		query := uri.Query()
+
+		for name, values := range r.query {
+			for _, value := range values {
+				query.Add(name, value)
+			}
+		}
+
+		uri.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequest(r.method, uri.String(), bytes.NewReader(r.body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header = r.header
+
+	for _, cookie := range r.cookies {
+		req.AddCookie(cookie)
+	}
+
+	doer := c.Doer
+
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+
+	return doer.Do(req.WithContext(ctx))
+}
+
+// stride:generate request
+type request struct {
+	method  string
+	url     string
+	path    string
+	query   url.Values
+	header  http.Header
+	cookies []*http.Cookie
+	body    []byte
+	err     error
+}
+
+// stride:generate new-request
+func newRequest(method, path string) *request {
+	return &request{
+		method: method,
+		path:   path,
+		query:  url.Values{},
+		header: http.Header{},
+	}
+}
+
+// stride:generate request:path
+func (r *request) setPath(name, style string, explode bool, value interface{}) {
+	values, fields, ok := split(value)
+	if !ok {
+		return
+	}
+
+	for index, item := range values {
+		values[index] = url.PathEscape(item)
+	}
+
+	for index, item := range fields {
+		fields[index] = [2]string{url.PathEscape(item[0]), url.PathEscape(item[1])}
+	}
+
+	var text string
+
+	switch style {
+	case "label":
+		separator := ","
+
+		if explode {
+			separator = "."
+		}
+
+		text = "." + join(values, fields, separator, explode)
+	case "matrix":
+		switch {
+		case fields != nil && explode:
+			text = ";" + join(nil, fields, ";", true)
+		case fields != nil:
+			text = ";" + name + "=" + join(nil, fields, ",", false)
+		case explode:
+			items := []string{}
+
+			for _, item := range values {
+				items = append(items, name+"="+item)
+			}
+
+			text = ";" + strings.Join(items, ";")
+		default:
+			text = ";" + name + "=" + strings.Join(values, ",")
+		}
+	default:
+		text = join(values, fields, ",", explode)
+	}
+
+	r.path = strings.Replace(r.path, "{"+name+"}", text, -1)
+}
+
+// stride:generate request:query
+func (r *request) setQuery(name, style string, explode bool, value interface{}) {
+	values, fields, ok := split(value)
+	if !ok {
+		return
+	}
+
+	switch {
+	case style == "deepObject":
+		for _, item := range fields {
+			r.query.Add(fmt.Sprintf("%s[%s]", name, item[0]), item[1])
+		}
+	case fields != nil && explode:
+		for _, item := range fields {
+			r.query.Add(item[0], item[1])
+		}
+	case fields != nil:
+		r.query.Add(name, join(nil, fields, ",", false))
+	case explode:
+		for _, item := range values {
+			r.query.Add(name, item)
+		}
+	case style == "spaceDelimited":
+		r.query.Add(name, strings.Join(values, " "))
+	case style == "pipeDelimited":
+		r.query.Add(name, strings.Join(values, "|"))
+	default:
+		r.query.Add(name, strings.Join(values, ","))
+	}
+}
+
+// stride:generate request:header
+func (r *request) setHeader(name, style string, explode bool, value interface{}) {
+	values, fields, ok := split(value)
+	if !ok {
+		return
+	}
+
+	// the simple style is the only one allowed for the headers
+	r.header.Set(name, join(values, fields, ",", explode))
+}
+
+// stride:generate request:cookie
+func (r *request) setCookie(name, style string, explode bool, value interface{}) {
+	values, fields, ok := split(value)
+	if !ok {
+		return
+	}
+
+	switch {
+	case fields != nil && explode:
+		for _, item := range fields {
+			r.cookies = append(r.cookies, &http.Cookie{Name: item[0], Value: item[1]})
+		}
+	case fields != nil:
+		r.cookies = append(r.cookies, &http.Cookie{Name: name, Value: join(nil, fields, ",", false)})
+	case explode:
+		for _, item := range values {
+			r.cookies = append(r.cookies, &http.Cookie{Name: name, Value: item})
+		}
+	default:
+		r.cookies = append(r.cookies, &http.Cookie{Name: name, Value: strings.Join(values, ",")})
+	}
+}
+
+// stride:generate request:accept
+func (r *request) setAccept(kinds ...string) {
+	if len(kinds) > 0 {
+		r.header.Set("Accept", strings.Join(kinds, ", "))
+	}
+}
+
+// stride:generate request:body
+func (r *request) setBody(kind string, value interface{}) {
+	// the request sends the first body that is set
+	if isNil(value) || r.header.Get("Content-Type") != "" {
+		return
+	}
+
+	media, _, err := mime.ParseMediaType(kind)
+	if err != nil {
+		r.err = err
+		return
+	}
+
+	switch {
+	case strings.HasSuffix(media, "json"):
+		r.body, r.err = json.Marshal(value)
+	case strings.HasSuffix(media, "xml"):
+		r.body, r.err = xml.Marshal(value)
+	case media == "application/x-www-form-urlencoded":
+		r.body, r.err = encodeForm(value)
+	case media == "text/plain":
+		r.body, r.err = encodeText(value)
+	case media == "application/octet-stream":
+		r.body, r.err = encodeStream(value)
+	default:
+		r.err = fmt.Errorf("unsupported request content-type: %s", kind)
+	}
+
+	r.header.Set("Content-Type", kind)
+}
+
+// stride:generate encode-form
+func encodeForm(value interface{}) ([]byte, error) {
+	item := reflect.Indirect(reflect.ValueOf(value))
+
+	if item.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unsupported form type: %s", item.Type())
+	}
+
+	values := url.Values{}
+
+	for index := 0; index < item.NumField(); index++ {
+		field := item.Type().Field(index)
+
+		if field.PkgPath != "" || isNil(item.Field(index).Interface()) {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+
+		// the empty values are omitted as by the json encoding
+		if omitEmpty(tag) && optional(item.Field(index).Interface()) == nil {
+			continue
+		}
+
+		value := reflect.Indirect(item.Field(index))
+
+		if _, ok := value.Interface().(encoding.TextMarshaler); ok {
+			values.Add(name, format(value))
+			continue
+		}
+
+		switch value.Kind() {
+		case reflect.Slice, reflect.Array:
+			// the arrays are sent as repeated values
+			for index := 0; index < value.Len(); index++ {
+				values.Add(name, format(value.Index(index)))
+			}
+		case reflect.Struct, reflect.Map, reflect.Interface:
+			// the objects are encoded as json
+			data, err := json.Marshal(value.Interface())
+			if err != nil {
+				return nil, err
+			}
+
+			values.Add(name, string(data))
+		default:
+			values.Add(name, format(value))
+		}
+	}
+
+	return []byte(values.Encode()), nil
+}
+
+// stride:generate omit-empty
+func omitEmpty(tag string) bool {
+	for _, option := range strings.Split(tag, ",")[1:] {
+		if option == "omitempty" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stride:generate encode-text
+func encodeText(value interface{}) ([]byte, error) {
+	item := reflect.Indirect(reflect.ValueOf(value))
+
+	if _, ok := item.Interface().(encoding.TextMarshaler); !ok {
+		switch item.Kind() {
+		case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Interface:
+			return nil, fmt.Errorf("unsupported text type: %s", item.Type())
+		}
+	}
+
+	return []byte(format(item)), nil
+}
+
+// stride:generate encode-stream
+func encodeStream(value interface{}) ([]byte, error) {
+	switch item := value.(type) {
+	case io.Reader:
+		return ioutil.ReadAll(item)
+	case []byte:
+		return item, nil
+	default:
+		return encodeText(value)
+	}
+}
+
+// stride:generate request:multipart
+func (r *request) setMultipart(value interface{}, encoding map[string]string) {
+	// the request sends the first body that is set
+	if isNil(value) || r.header.Get("Content-Type") != "" {
+		return
+	}
+
+	item := reflect.Indirect(reflect.ValueOf(value))
+
+	if item.Kind() != reflect.Struct {
+		r.err = fmt.Errorf("unsupported multipart type: %s", item.Type())
+		return
+	}
+
+	var (
+		buffer = &bytes.Buffer{}
+		writer = multipart.NewWriter(buffer)
+	)
+
+	for index := 0; index < item.NumField(); index++ {
+		field := item.Type().Field(index)
+
+		if field.PkgPath != "" || isNil(item.Field(index).Interface()) {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+
+		// the empty values are omitted as by the json encoding
+		if omitEmpty(tag) && optional(item.Field(index).Interface()) == nil {
+			continue
+		}
+
+		if r.err = writePart(writer, name, item.Field(index), encoding[name]); r.err != nil {
+			return
+		}
+	}
+
+	if r.err = writer.Close(); r.err != nil {
+		return
+	}
+
+	r.body = buffer.Bytes()
+	r.header.Set("Content-Type", writer.FormDataContentType())
+}
+
+// stride:generate write-part
+func writePart(writer *multipart.Writer, name string, value reflect.Value, kind string) error {
+	value = reflect.Indirect(value)
+
+	if !value.IsValid() {
+		return nil
+	}
+
+	// the files are the values that can be read such as *File
+	if reader, ok := value.Interface().(io.Reader); ok {
+		return writeFile(writer, name, value, reader, kind)
+	}
+
+	if _, ok := value.Interface().(encoding.TextMarshaler); !ok {
+		switch value.Kind() {
+		case reflect.Slice, reflect.Array:
+			if value.Type().Elem().Kind() == reflect.Uint8 {
+				return writeText(writer, name, base64.StdEncoding.EncodeToString(value.Bytes()), kind)
+			}
+
+			// the arrays are sent as repeated parts
+			for index := 0; index < value.Len(); index++ {
+				if err := writePart(writer, name, value.Index(index), kind); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		case reflect.Struct, reflect.Map, reflect.Interface:
+			// the objects are encoded as json by default
+			if kind == "" {
+				kind = "application/json"
+			}
+		}
+	}
+
+	if media, _, err := mime.ParseMediaType(kind); err == nil && strings.HasSuffix(media, "json") {
+		data, err := json.Marshal(value.Interface())
+		if err != nil {
+			return err
+		}
+
+		return writeText(writer, name, string(data), kind)
+	}
+
+	return writeText(writer, name, format(value), kind)
+}
+
+// stride:generate write-file
+func writeFile(writer *multipart.Writer, name string, value reflect.Value, reader io.Reader, kind string) error {
+	filename := name
+
+	if value.Kind() == reflect.Struct {
+		if field := value.FieldByName("Reader"); field.IsValid() && field.Kind() == reflect.Interface && field.IsNil() {
+			return nil
+		}
+
+		if field := value.FieldByName("Name"); field.IsValid() && field.Kind() == reflect.String && field.String() != "" {
+			filename = field.String()
+		}
+
+		if field := value.FieldByName("ContentType"); field.IsValid() && field.Kind() == reflect.String && field.String() != "" {
+			kind = field.String()
+		}
+	}
+
+	if kind == "" {
+		kind = "application/octet-stream"
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, escape(name), escape(filename)))
+	header.Set("Content-Type", kind)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(part, reader)
+	return err
+}
+
+// stride:generate write-text
+func writeText(writer *multipart.Writer, name, text, kind string) error {
+	if kind == "" {
+		return writer.WriteField(name, text)
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, escape(name)))
+	header.Set("Content-Type", kind)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(part, text)
+	return err
+}
+
+// stride:generate escape
+func escape(text string) string {
+	return strings.NewReplacer("\\", "\\\\", `"`, "\\\"").Replace(text)
+}
+
+// stride:generate decode-body
+func decodeBody(response *http.Response, target interface{}) error {
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	media, _, err := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	if err != nil {
+		media = "application/json"
+	}
+
+	switch {
+	case strings.HasSuffix(media, "json"):
+		return json.Unmarshal(data, target)
+	case strings.HasSuffix(media, "xml"):
+		return xml.Unmarshal(data, target)
+	case media == "text/plain":
+		return decodeText(string(data), reflect.ValueOf(target).Elem())
+	default:
+		return fmt.Errorf("unsupported response content-type: %s", media)
+	}
+}
+
+// stride:generate decode-header
+func decodeHeader(header http.Header, name string, target interface{}) error {
+	text := header.Get(name)
+
+	if text == "" {
+		return nil
+	}
+
+	return decodeText(text, reflect.ValueOf(target).Elem())
+}
+
+// stride:generate decode-error
+func decodeError(response *http.Response) error {
+	data, err := ioutil.ReadAll(io.LimitReader(response.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	return &ResponseError{
+		StatusCode: response.StatusCode,
+		Body:       data,
+	}
+}
+
+// stride:generate decode-text
+func decodeText(text string, value reflect.Value) error {
+	if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(text))
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		item := reflect.New(value.Type().Elem())
+
+		if err := decodeText(text, item.Elem()); err != nil {
+			return err
+		}
+
+		value.Set(item)
+	case reflect.Interface:
+		value.Set(reflect.ValueOf(text))
+	case reflect.String:
+		value.SetString(text)
+	case reflect.Bool:
+		item, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+
+		value.SetBool(item)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		item, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		value.SetInt(item)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		item, err := strconv.ParseUint(text, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		value.SetUint(item)
+	case reflect.Float32, reflect.Float64:
+		item, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return err
+		}
+
+		value.SetFloat(item)
+	case reflect.Slice:
+		items := strings.Split(text, ",")
+		slice := reflect.MakeSlice(value.Type(), len(items), len(items))
+
+		for index, item := range items {
+			if err := decodeText(strings.TrimSpace(item), slice.Index(index)); err != nil {
+				return err
+			}
+		}
+
+		value.Set(slice)
+	default:
+		return fmt.Errorf("unsupported header type: %s", value.Type())
+	}
+
+	return nil
+}
+
+// split returns the values of an array or the fields of an object
+// stride:generate split
+func split(value interface{}) ([]string, [][2]string, bool) {
+	if isNil(value) {
+		return nil, nil, false
+	}
+
+	item := reflect.Indirect(reflect.ValueOf(value))
+
+	if _, ok := item.Interface().(encoding.TextMarshaler); ok {
+		return []string{format(item)}, nil, true
+	}
+
+	switch item.Kind() {
+	case reflect.Slice, reflect.Array:
+		values := []string{}
+
+		for index := 0; index < item.Len(); index++ {
+			values = append(values, format(item.Index(index)))
+		}
+
+		return values, nil, true
+	case reflect.Map:
+		fields := [][2]string{}
+
+		for _, key := range item.MapKeys() {
+			fields = append(fields, [2]string{format(key), format(item.MapIndex(key))})
+		}
+
+		sort.Slice(fields, func(i, j int) bool {
+			return fields[i][0] < fields[j][0]
+		})
+
+		return nil, fields, true
+	case reflect.Struct:
+		fields := [][2]string{}
+
+		for index := 0; index < item.NumField(); index++ {
+			field := item.Type().Field(index)
+
+			if field.PkgPath != "" || isNil(item.Field(index).Interface()) {
+				continue
+			}
+
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+
+			if name == "" {
+				name = field.Name
+			}
+
+			fields = append(fields, [2]string{name, format(item.Field(index))})
+		}
+
+		return nil, fields, true
+	default:
+		return []string{format(item)}, nil, true
+	}
+}
+
+// stride:generate join
+func join(values []string, fields [][2]string, separator string, explode bool) string {
+	if fields == nil {
+		return strings.Join(values, separator)
+	}
+
+	items := []string{}
+
+	for _, field := range fields {
+		if explode {
+			items = append(items, field[0]+"="+field[1])
+		} else {
+			items = append(items, field[0], field[1])
+		}
+	}
+
+	return strings.Join(items, separator)
+}
+
+// stride:generate format
+func format(value reflect.Value) string {
+	value = reflect.Indirect(value)
+
+	if !value.IsValid() {
+		return ""
+	}
+
+	if marshaler, ok := value.Interface().(encoding.TextMarshaler); ok {
+		if data, err := marshaler.MarshalText(); err == nil {
+			return string(data)
+		}
+	}
+
+	return fmt.Sprint(value.Interface())
+}
+
+// stride:generate is-nil
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	item := reflect.ValueOf(value)
+
+	switch item.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return item.IsNil()
+	default:
+		return false
+	}
+}
+
+// optional returns nil if the value of an optional parameter is not set. The
+// zero values and the empty arrays and objects are not sent.
+// stride:generate optional
+func optional(value interface{}) interface{} {
+	if isNil(value) {
+		return nil
+	}
+
+	item := reflect.ValueOf(value)
+
+	switch item.Kind() {
+	case reflect.Ptr:
+		return value
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if item.Len() == 0 {
+			return nil
+		}
+	default:
+		if item.IsZero() {
+			return nil
+		}
+	}
+
+	return value
+}
+
+// stride:generate expression
+var expression = regexp.MustCompile(`\{(\$[^{}]+)\}`)
+
+// Expand resolves the runtime expressions of a callback url such as
+// {$request.body#/callbackUrl} by the request that registers the callback and
+// its decoded body
+// stride:generate expand
+func Expand(text string, r *http.Request, body interface{}) (string, error) {
+	return expand(text, func(text string) (string, bool) {
+		return evaluate(text, r, body)
+	})
+}
+
+// stride:generate expand-func
+func expand(text string, evaluate func(string) (string, bool)) (string, error) {
+	// the text may be a single expression without braces
+	if strings.HasPrefix(text, "$") {
+		text = "{" + text + "}"
+	}
+
+	var err error
+
+	result := expression.ReplaceAllStringFunc(text, func(match string) string {
+		value, ok := evaluate(match[1 : len(match)-1])
+		if !ok && err == nil {
+			err = fmt.Errorf("cannot resolve expression: %s", match)
+		}
+
+		return value
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+// stride:generate evaluate
+func evaluate(text string, r *http.Request, body interface{}) (string, bool) {
+	switch {
+	case text == "$url":
+		return r.URL.String(), true
+	case text == "$method":
+		return r.Method, true
+	case strings.HasPrefix(text, "$request.path."):
+		value := chi.URLParam(r, strings.TrimPrefix(text, "$request.path."))
+		return value, value != ""
+	case strings.HasPrefix(text, "$request.query."):
+		values, ok := r.URL.Query()[strings.TrimPrefix(text, "$request.query.")]
+		if !ok || len(values) == 0 {
+			return "", false
+		}
+
+		return values[0], true
+	case strings.HasPrefix(text, "$request.header."):
+		value := r.Header.Get(strings.TrimPrefix(text, "$request.header."))
+		return value, value != ""
+	case text == "$request.body" || strings.HasPrefix(text, "$request.body#"):
+		return pointer(body, strings.TrimPrefix(strings.TrimPrefix(text, "$request.body"), "#"))
+	default:
+		return "", false
+	}
+}
+
+// pointer returns the value of the body at the given JSON pointer
+// stride:generate pointer
+func pointer(body interface{}, path string) (string, bool) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return "", false
+	}
+
+	var value interface{}
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", false
+	}
+
+	if path != "" {
+		for _, token := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+			token = strings.Replace(token, "~1", "/", -1)
+			token = strings.Replace(token, "~0", "~", -1)
+
+			switch node := value.(type) {
+			case map[string]interface{}:
+				item, ok := node[token]
+				if !ok {
+					return "", false
+				}
+
+				value = item
+			case []interface{}:
+				index, err := strconv.Atoi(token)
+				if err != nil || index < 0 || index >= len(node) {
+					return "", false
+				}
+
+				value = node[index]
+			default:
+				return "", false
+			}
+		}
+	}
+
+	switch value := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return value, true
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", false
+		}
+
+		return string(data), true
+	}
+}
+
+// link resolves the parameters of a link by the request and the response of
+// the operation that declares it
+// stride:generate link
+type link struct {
+	client *Client
+	input  interface{}
+	status int
+	header http.Header
+	body   interface{}
+}
+
+// stride:generate link:set
+func (l *link) set(text string, target interface{}) error {
+	value, err := expand(text, l.evaluate)
+	if err != nil {
+		return err
+	}
+
+	var (
+		item = reflect.ValueOf(target).Elem()
+		kind = item.Type()
+	)
+
+	for kind.Kind() == reflect.Ptr {
+		kind = kind.Elem()
+	}
+
+	// the objects are JSON encoded
+	if _, ok := reflect.New(kind).Interface().(encoding.TextUnmarshaler); !ok {
+		if kind.Kind() == reflect.Struct || kind.Kind() == reflect.Map {
+			return json.Unmarshal([]byte(value), target)
+		}
+	}
+
+	return decodeText(value, item)
+}
+
+// stride:generate link:evaluate
+func (l *link) evaluate(text string) (string, bool) {
+	switch {
+	case text == "$statusCode":
+		return strconv.Itoa(l.status), true
+	case strings.HasPrefix(text, "$request.path."):
+		return parameter(l.input, "Path", strings.TrimPrefix(text, "$request.path."))
+	case strings.HasPrefix(text, "$request.query."):
+		return parameter(l.input, "Query", strings.TrimPrefix(text, "$request.query."))
+	case strings.HasPrefix(text, "$request.header."):
+		return parameter(l.input, "Header", strings.TrimPrefix(text, "$request.header."))
+	case text == "$request.body" || strings.HasPrefix(text, "$request.body#"):
+		return pointer(bodyOf(l.input), strings.TrimPrefix(strings.TrimPrefix(text, "$request.body"), "#"))
+	case strings.HasPrefix(text, "$response.header."):
+		value := l.header.Get(strings.TrimPrefix(text, "$response.header."))
+		return value, value != ""
+	case text == "$response.body" || strings.HasPrefix(text, "$response.body#"):
+		return pointer(l.body, strings.TrimPrefix(strings.TrimPrefix(text, "$response.body"), "#"))
+	default:
+		return "", false
+	}
+}
+
+// parameter returns the value of an input parameter by its name
+// stride:generate parameter
+func parameter(input interface{}, kind, name string) (string, bool) {
+	if isNil(input) {
+		return "", false
+	}
+
+	item := reflect.Indirect(reflect.Indirect(reflect.ValueOf(input)).FieldByName(kind))
+
+	if !item.IsValid() || item.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	key := strings.ToLower(kind)
+
+	for index := 0; index < item.NumField(); index++ {
+		field := item.Type().Field(index)
+
+		if strings.Split(field.Tag.Get(key), ",")[0] != name {
+			continue
+		}
+
+		if isNil(item.Field(index).Interface()) {
+			return "", false
+		}
+
+		return format(item.Field(index)), true
+	}
+
+	return "", false
+}
+
+// bodyOf returns the body of an input
+// stride:generate body-of
+func bodyOf(input interface{}) interface{} {
+	if isNil(input) {
+		return nil
+	}
+
+	item := reflect.Indirect(reflect.ValueOf(input))
+
+	for index := 0; index < item.NumField(); index++ {
+		field := item.Type().Field(index)
+
+		if !strings.HasSuffix(field.Name, "Body") || isNil(item.Field(index).Interface()) {
+			continue
+		}
+
+		return item.Field(index).Interface()
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+{{- comment (camelize .function) "follows the" .link "link of the" .field "response" }}
+{{- comment .description }}
+{{- comment "stride:generate" (key .receiver .function) }}
+func (x *{{ .receiver }}) {{ .function | camelize }}(ctx context.Context) (*{{ .target }}Response, error) {
+	if x.link == nil || x.{{ .field }} == nil {
+		return nil, fmt.Errorf("{{ .operation | dasherize }}: link {{ .link }}: response {{ .field | dasherize }} not found")
+	}
+
+	link := *x.link
+	{{- if .body }}
+	link.body = x.{{ .field }}.Body
+	{{- end }}
+
+	input := &{{ .models }}{{ .input }}{
+		{{- range $kind, $type := .kinds }}
+		{{ $kind }}: &{{ $.models }}{{ $type }}{},
+		{{- end }}
+	}
+	{{- range .parameters }}
+
+	if err := link.set({{ printf "%q" .value }}, &input.{{ .field }}); err != nil {
+		return nil, err
+	}
+	{{- end }}
+
+	return New{{ .client }}(link.client).{{ .target }}(ctx, input)
+}
@@ -0,0 +1,7 @@
+{{- comment (printf "New%s" .receiver) "creates a new client for the" (dasherize .controller) "operations" }}
+{{- comment "stride:generate" (key "new" .receiver) }}
+func New{{ .receiver }}(client *Client) *{{ .receiver }} {
+	return &{{ .receiver }}{
+		Client: client,
+	}
+}
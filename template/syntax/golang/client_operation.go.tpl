@@ -0,0 +1,109 @@
+{{- if .callback }}
+{{- comment (camelize .function) "sends the callback" (uppercase .method) .path "to the given url" }}
+{{- else }}
+{{- comment (camelize .function) "calls endpoint" (uppercase .method) .path }}
+{{- end }}
+{{- comment .summary }}
+{{- comment .description }}
+{{- comment .deprecated }}
+{{- comment "stride:generate" (key .receiver .function) }}
+func (x *{{ .receiver }}) {{ .function | camelize }}(ctx context.Context, {{ if .callback }}url string, {{ end }}input *{{ .models }}{{ .function | camelize }}Input) (*{{ .function | camelize }}Response, error) {
+	{{- if .callback }}
+	request := newRequest({{ printf "%q" (uppercase .method) }}, "")
+	request.url = url
+	{{- else }}
+	request := newRequest({{ printf "%q" (uppercase .method) }}, {{ printf "%q" .path }})
+	{{- end }}
+	{{- range $kind, $parameters := .parameters }}
+
+	if input.{{ $kind }} != nil {
+		{{- range $parameters }}
+		request.set{{ $kind }}({{ printf "%q" .Name }}, {{ printf "%q" .Style }}, {{ .Explode }}, {{ if .Required }}input.{{ $kind }}.{{ .Identifier | camelize }}{{ else }}optional(input.{{ $kind }}.{{ .Identifier | camelize }}){{ end }})
+		{{- end }}
+	}
+	{{- end }}
+	{{- range .bodies }}
+	{{- if .IsMultipart }}
+
+	request.setMultipart(input.{{ .Field }}, {{ if .Request.Encoding }}map[string]string{ {{- range $name, $kind := .Request.Encoding }}{{ printf "%q" $name }}: {{ printf "%q" $kind }}, {{ end }}}{{ else }}nil{{ end }})
+	{{- else }}
+
+	request.setBody({{ printf "%q" .ContentType }}, input.{{ .Field }})
+	{{- end }}
+	{{- end }}
+	{{- if .accept }}
+
+	request.setAccept({{ range $index, $kind := .accept }}{{ if $index }}, {{ end }}{{ printf "%q" $kind }}{{ end }})
+	{{- end }}
+
+	response, err := x.Client.do(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	output := &{{ .function | camelize }}Response{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+	}
+	{{- if .links }}
+
+	output.link = &link{
+		client: x.Client,
+		input:  input,
+		status: response.StatusCode,
+		header: response.Header,
+	}
+	{{- end }}
+
+	switch response.StatusCode {
+	{{- range .responses }}
+	{{- if .default }}
+	default:
+	{{- else }}
+	case {{ .code }}:
+	{{- end }}
+		output.{{ .field }} = &{{ $.models }}{{ .output }}{}
+		{{- $field := .field }}
+		{{- $output := .output }}
+		{{- if .headers }}
+		output.{{ $field }}.Header = &{{ $.models }}{{ $output }}Header{}
+		{{- range .headers }}
+
+		if err := decodeHeader(response.Header, {{ printf "%q" .Name }}, &output.{{ $field }}.Header.{{ .Identifier | camelize }}); err != nil {
+			return nil, err
+		}
+		{{- end }}
+		{{- end }}
+		{{- if .stream }}
+
+		data, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		output.{{ $field }}.Body = {{ if .pointer }}&{{ end }}{{ $.models }}{{ .stream }}{
+			Reader:      bytes.NewReader(data),
+			ContentType: response.Header.Get("Content-Type"),
+		}
+		{{- else if .body }}
+
+		if err := decodeBody(response, &output.{{ $field }}.Body); err != nil {
+			return nil, err
+		}
+		{{- end }}
+		{{- if .validate }}
+
+		if err := output.{{ $field }}.ValidateResponse(); err != nil {
+			return nil, err
+		}
+		{{- end }}
+	{{- end }}
+	{{- if not .fallback }}
+	default:
+		return nil, decodeError(response)
+	{{- end }}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,335 @@
+package {{ .package }}
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// stride:generate max-memory
+const maxMemory = 32 << 20
+
+// File is a file uploaded by a request or streamed by a response
+// stride:generate file
+type File struct {
+	io.Reader
+	// stride:generate file:name
+	Name string
+	// stride:generate file:content-type
+	ContentType string
+}
+
+// Close closes the reader if it's a closer
+// stride:generate file:close
+func (f *File) Close() error {
+	if closer, ok := f.Reader.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// MultipartUnmarshaler unmarshals the parts of a multipart form
+// stride:generate multipart-unmarshaler
+type MultipartUnmarshaler interface {
+	UnmarshalMultipart(form *multipart.Form) error
+}
+
+// FileUnmarshaler unmarshals a binary stream
+// stride:generate file-unmarshaler
+type FileUnmarshaler interface {
+	UnmarshalFile(file *File) error
+}
+
+// DecodeBody decodes the bodies that the binder does not support such as
+// multipart, plain text, binary and the vendor JSON and XML types. It returns
+// true if the body is decoded. The other content types are left to the binder.
+// stride:generate decode-body
+func DecodeBody(r *http.Request, input interface{}) (bool, error) {
+	media, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false, nil
+	}
+
+	switch {
+	case media == "multipart/form-data":
+		if unmarshaler, ok := input.(MultipartUnmarshaler); ok {
+			if err := r.ParseMultipartForm(maxMemory); err != nil {
+				return true, err
+			}
+
+			return true, unmarshaler.UnmarshalMultipart(r.MultipartForm)
+		}
+	case media == "text/plain":
+		if unmarshaler, ok := input.(encoding.TextUnmarshaler); ok {
+			data, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				return true, err
+			}
+
+			return true, unmarshaler.UnmarshalText(data)
+		}
+	case media == "application/octet-stream":
+		if unmarshaler, ok := input.(FileUnmarshaler); ok {
+			return true, unmarshaler.UnmarshalFile(&File{
+				Reader:      r.Body,
+				ContentType: r.Header.Get("Content-Type"),
+			})
+		}
+	case media != "application/json" && strings.HasSuffix(media, "json"):
+		return true, json.NewDecoder(r.Body).Decode(input)
+	case media != "application/xml" && media != "text/xml" && strings.HasSuffix(media, "xml"):
+		return true, xml.NewDecoder(r.Body).Decode(input)
+	}
+
+	return false, nil
+}
+
+// WithoutBody returns a copy of the request without the body. The binder
+// binds only the parameters of the requests whose body is decoded by
+// DecodeBody.
+// stride:generate without-body
+func WithoutBody(r *http.Request) *http.Request {
+	request := r.WithContext(r.Context())
+	request.Body = http.NoBody
+	request.ContentLength = 0
+	request.Header = r.Header.Clone()
+	request.Header.Del("Content-Type")
+	request.Header.Del("Content-Length")
+	return request
+}
+
+// stride:generate decode-text
+func decodeText(data []byte, target interface{}) error {
+	return decodePart([]string{string(data)}, reflect.ValueOf(target).Elem(), "")
+}
+
+// stride:generate decode-stream
+func decodeStream(file *File, target interface{}) error {
+	return setFile(file, reflect.ValueOf(target).Elem())
+}
+
+// stride:generate encode-text
+func encodeText(value interface{}) ([]byte, error) {
+	item := reflect.Indirect(reflect.ValueOf(value))
+
+	if !item.IsValid() {
+		return nil, nil
+	}
+
+	if marshaler, ok := item.Interface().(encoding.TextMarshaler); ok {
+		return marshaler.MarshalText()
+	}
+
+	switch item.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Interface:
+		return nil, fmt.Errorf("unsupported text type: %s", item.Type())
+	default:
+		return []byte(fmt.Sprint(item.Interface())), nil
+	}
+}
+
+// stride:generate decode-multipart-form
+func decodeMultipart(form *multipart.Form, target interface{}, encoding map[string]string) error {
+	value := reflect.Indirect(reflect.ValueOf(target))
+
+	if value.Kind() != reflect.Struct {
+		return fmt.Errorf("unsupported multipart type: %s", value.Type())
+	}
+
+	for index := 0; index < value.NumField(); index++ {
+		field := value.Type().Field(index)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+
+		if files, ok := form.File[name]; ok && len(files) > 0 {
+			if err := decodeFiles(files, value.Field(index)); err != nil {
+				return fmt.Errorf("invalid multipart part: %s: %v", name, err)
+			}
+
+			continue
+		}
+
+		if values, ok := form.Value[name]; ok && len(values) > 0 {
+			if err := decodePart(values, value.Field(index), encoding[name]); err != nil {
+				return fmt.Errorf("invalid multipart part: %s: %v", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// stride:generate decode-files
+func decodeFiles(files []*multipart.FileHeader, value reflect.Value) error {
+	// the byte slices are decoded from the content of the file
+	if value.Kind() != reflect.Slice || value.Type().Elem().Kind() == reflect.Uint8 {
+		return decodeFile(files[0], value)
+	}
+
+	slice := reflect.MakeSlice(value.Type(), len(files), len(files))
+
+	for index, header := range files {
+		if err := decodeFile(header, slice.Index(index)); err != nil {
+			return err
+		}
+	}
+
+	value.Set(slice)
+	return nil
+}
+
+// stride:generate decode-file
+func decodeFile(header *multipart.FileHeader, value reflect.Value) error {
+	reader, err := header.Open()
+	if err != nil {
+		return err
+	}
+
+	file := &File{
+		Reader:      reader,
+		Name:        header.Filename,
+		ContentType: header.Header.Get("Content-Type"),
+	}
+
+	return setFile(file, value)
+}
+
+// stride:generate set-file
+func setFile(file *File, value reflect.Value) error {
+	source := reflect.ValueOf(file)
+
+	switch {
+	case source.Type().ConvertibleTo(value.Type()):
+		value.Set(source.Convert(value.Type()))
+	case source.Elem().Type().ConvertibleTo(value.Type()):
+		value.Set(source.Elem().Convert(value.Type()))
+	default:
+		// the other types are read from the content of the file
+		defer file.Close()
+
+		data, err := ioutil.ReadAll(file)
+		if err != nil {
+			return err
+		}
+
+		if value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8 {
+			value.SetBytes(data)
+			return nil
+		}
+
+		return decodePart([]string{string(data)}, value, file.ContentType)
+	}
+
+	return nil
+}
+
+// stride:generate decode-part
+func decodePart(values []string, value reflect.Value, kind string) error {
+	text := values[0]
+
+	if media, _, err := mime.ParseMediaType(kind); err == nil && strings.HasSuffix(media, "json") {
+		return json.Unmarshal([]byte(text), value.Addr().Interface())
+	}
+
+	if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(text))
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		item := reflect.New(value.Type().Elem())
+
+		if err := decodePart(values, item.Elem(), kind); err != nil {
+			return err
+		}
+
+		value.Set(item)
+	case reflect.Interface:
+		if !json.Valid([]byte(text)) {
+			value.Set(reflect.ValueOf(text))
+			return nil
+		}
+
+		return json.Unmarshal([]byte(text), value.Addr().Interface())
+	case reflect.Struct, reflect.Map:
+		// the objects are encoded as json by default
+		return json.Unmarshal([]byte(text), value.Addr().Interface())
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			data, err := base64.StdEncoding.DecodeString(text)
+			if err != nil {
+				return err
+			}
+
+			value.SetBytes(data)
+			return nil
+		}
+
+		// the arrays are sent as repeated parts
+		slice := reflect.MakeSlice(value.Type(), len(values), len(values))
+
+		for index, item := range values {
+			if err := decodePart([]string{item}, slice.Index(index), kind); err != nil {
+				return err
+			}
+		}
+
+		value.Set(slice)
+	case reflect.String:
+		value.SetString(text)
+	case reflect.Bool:
+		item, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+
+		value.SetBool(item)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		item, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		value.SetInt(item)
+	case reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		item, err := strconv.ParseUint(text, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		value.SetUint(item)
+	case reflect.Float32, reflect.Float64:
+		item, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return err
+		}
+
+		value.SetFloat(item)
+	default:
+		return fmt.Errorf("unsupported multipart part type: %s", value.Type())
+	}
+
+	return nil
+}
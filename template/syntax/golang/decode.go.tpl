@@ -1,13 +1,42 @@
+{{- range .decoders }}
+{{- if eq .codec "json" }}
 {{- comment "UnmarshalJSON unmarshals from valid JSON" }}
-{{- comment "stride:generate" (key .receiver "UnmarshalJSON") }}
-func (x *{{ .receiver | camelize }}) UnmarshalJSON(data []byte) error {
-  x.Body  = &{{ .body | camelize }}{}
-  return json.Unmarshal(data, x.Body)
+{{- comment "stride:generate" (key $.receiver "UnmarshalJSON") }}
+func (x *{{ $.receiver | camelize }}) UnmarshalJSON(data []byte) error {
+  return json.Unmarshal(data, &x.{{ .field }})
 }
+{{- end }}
+{{- if eq .codec "xml" }}
 
 {{- comment "UnmarshalXML unmarshals from valid XML" }}
-{{- comment "stride:generate" (key .receiver "UnmarshalXML") }}
-func (x *{{ .receiver | camelize }}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
-  x.Body  = &{{ .body | camelize }}{}
-  return d.DecodeElement(x.Body, &start)
+{{- comment "stride:generate" (key $.receiver "UnmarshalXML") }}
+func (x *{{ $.receiver | camelize }}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+  return d.DecodeElement(&x.{{ .field }}, &start)
 }
+{{- end }}
+{{- if eq .codec "multipart" }}
+
+{{- comment "UnmarshalMultipart unmarshals from a multipart form" }}
+{{- comment "stride:generate" (key $.receiver "UnmarshalMultipart") }}
+func (x *{{ $.receiver | camelize }}) UnmarshalMultipart(form *multipart.Form) error {
+  x.{{ .field }}  = &{{ .body | camelize }}{}
+  return decodeMultipart(form, x.{{ .field }}, {{ if .encoding }}map[string]string{ {{- range $name, $kind := .encoding }}{{ printf "%q" $name }}: {{ printf "%q" $kind }}, {{ end }}}{{ else }}nil{{ end }})
+}
+{{- end }}
+{{- if eq .codec "text" }}
+
+{{- comment "UnmarshalText unmarshals from plain text" }}
+{{- comment "stride:generate" (key $.receiver "UnmarshalText") }}
+func (x *{{ $.receiver | camelize }}) UnmarshalText(data []byte) error {
+  return decodeText(data, &x.{{ .field }})
+}
+{{- end }}
+{{- if eq .codec "binary" }}
+
+{{- comment "UnmarshalFile unmarshals from a binary stream" }}
+{{- comment "stride:generate" (key $.receiver "UnmarshalFile") }}
+func (x *{{ $.receiver | camelize }}) UnmarshalFile(file *File) error {
+  return decodeStream(file, &x.{{ .field }})
+}
+{{- end }}
+{{- end }}
@@ -1,11 +1,25 @@
+{{- range .encoders }}
+{{- if eq . "json" }}
 {{- comment "MarshalJSON marshals into valid JSON" }}
-{{- comment "stride:generate" (key .receiver "MarshalJSON") }}
-func (x {{ .receiver | camelize }}) MarshalJSON() ([]byte, error) {
+{{- comment "stride:generate" (key $.receiver "MarshalJSON") }}
+func (x {{ $.receiver | camelize }}) MarshalJSON() ([]byte, error) {
   return json.Marshal(x.Body)
 }
+{{- end }}
+{{- if eq . "xml" }}
 
 {{- comment "MarshalXML marshals into valid XML" }}
-{{- comment "stride:generate" (key .receiver "MarshalXML") }}
-func (x {{ .receiver | camelize }}) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+{{- comment "stride:generate" (key $.receiver "MarshalXML") }}
+func (x {{ $.receiver | camelize }}) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	return e.EncodeElement(x.Body, start)
 }
+{{- end }}
+{{- if eq . "text" }}
+
+{{- comment "MarshalText marshals into plain text" }}
+{{- comment "stride:generate" (key $.receiver "MarshalText") }}
+func (x {{ $.receiver | camelize }}) MarshalText() ([]byte, error) {
+	return encodeText(x.Body)
+}
+{{- end }}
+{{- end }}
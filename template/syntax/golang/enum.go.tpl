@@ -0,0 +1,44 @@
+{{- comment "IsValid returns true if the value is defined by the enum" }}
+{{- comment "stride:generate" (key .receiver "IsValid") }}
+func (x {{ .receiver }}) IsValid() bool {
+	switch x {
+	{{- if .cases }}
+	case {{ .cases }}:
+		return true
+	{{- end }}
+	default:
+		return false
+	}
+}
+{{- if .text }}
+
+{{- comment "MarshalText marshals the value into text" }}
+{{- comment "stride:generate" (key .receiver "MarshalText") }}
+func (x {{ .receiver }}) MarshalText() ([]byte, error) {
+	if !x.IsValid() {
+		return nil, fmt.Errorf("{{ .receiver | dasherize }}: unknown value %q", string(x))
+	}
+
+	return []byte(x), nil
+}
+
+{{- comment "UnmarshalText unmarshals the value from text" }}
+{{- comment "stride:generate" (key .receiver "UnmarshalText") }}
+func (x *{{ .receiver }}) UnmarshalText(data []byte) error {
+	value := {{ .receiver }}(data)
+
+	if !value.IsValid() {
+		return fmt.Errorf("{{ .receiver | dasherize }}: unknown value %q", string(data))
+	}
+
+	*x = value
+	return nil
+}
+{{- else }}
+
+{{- comment "String returns the string representation of the value" }}
+{{- comment "stride:generate" (key .receiver "String") }}
+func (x {{ .receiver }}) String() string {
+	return fmt.Sprint({{ .kind }}(x))
+}
+{{- end }}
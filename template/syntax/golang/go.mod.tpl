@@ -0,0 +1,12 @@
+module {{ .module }}
+
+go 1.13
+
+require (
+	github.com/go-chi/chi v4.1.1+incompatible
+	github.com/go-playground/validator/v10 v10.2.0
+	github.com/onsi/ginkgo v1.12.0
+	github.com/onsi/gomega v1.9.0
+	github.com/phogolabs/cli v0.0.0-20191212161310-ce689d871370
+	github.com/phogolabs/log v0.0.0-20191127172145-f737d8658073
+)
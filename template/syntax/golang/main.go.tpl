@@ -9,6 +9,10 @@ import (
 	"github.com/phogolabs/cli"
 	"github.com/phogolabs/log"
 	"github.com/phogolabs/log/handler/json"
+	{{- if .handlers.Import }}
+
+	{{ .handlers.Name }} "{{ .handlers.Import }}"
+	{{- end }}
 )
 
 // Version represents the application version which is set on compile time
@@ -57,7 +61,7 @@ func before(ctx *cli.Context) error {
 }
 
 func run(ctx *cli.Context) error {
-	server := service.NewServer(&service.Config{
+	server := {{ .handlers.Name }}.NewServer(&{{ .handlers.Name }}.Config{
 		Addr: ctx.String("listen-addr"),
 	})
 
@@ -2,7 +2,19 @@
 {{- comment "stride:generate" (key .receiver "mount") }}
 func (x *{{ .receiver | camelize }}) Mount(r chi.Router) {
 	{{- range .operations }}
-	r.{{ .Method | titleize }}("{{ .Path }}", x.{{ .Name | camelize }})
+	{{- if .IsSecured }}
+	r.With(authenticate(
+		{{- range .Security }}
+		[]authenticateFunc{
+			{{- range . }}
+			authenticate{{ .Name | camelize }}(x.Authenticator{{ range .Scopes }}, {{ printf "%q" . }}{{ end }}),
+			{{- end }}
+		},
+		{{- end }}
+	)).{{ .Method | titleize }}("{{ .Path }}", x.{{ .Identifier | camelize }})
+	{{- else }}
+	r.{{ .Method | titleize }}("{{ .Path }}", x.{{ .Identifier | camelize }})
+	{{- end }}
 	{{- end }}
 
 	// stride:define body:start
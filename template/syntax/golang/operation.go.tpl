@@ -7,14 +7,43 @@ func (x *{{ .receiver | camelize }}) {{ .function | camelize }}(w http.ResponseW
 	reactor := restify.NewReactor(w, r)
 
 	var (
-		input  = &{{ .function | camelize }}Input{}
-		output = &{{ .function | camelize }}Output{}
+		input  = &{{ .models }}{{ .function | camelize }}Input{}
+		output = &{{ .models }}{{ .function | camelize }}Output{}
 	)
 
+	{{- if .decode }}
+
+	decoded, err := {{ .models }}DecodeBody(r, input)
+	if err != nil {
+		reactor.Render(err)
+		return
+	}
+
+	binder := reactor
+
+	// the binder binds only the parameters of the decoded bodies
+	if decoded {
+		binder = restify.NewReactor(w, {{ .models }}WithoutBody(r))
+	}
+
+	if err := binder.Bind(input); err != nil {
+		reactor.Render(err)
+		return
+	}
+	{{- else }}
+
 	if err := reactor.Bind(input); err != nil {
 		reactor.Render(err)
 		return
 	}
+	{{- end }}
+	{{- if .validate }}
+
+	if err := input.ValidateRequest(); err != nil {
+		reactor.Render(err)
+		return
+	}
+	{{- end }}
 
 	// stride:define body:start
 	// NOTE: not implemented
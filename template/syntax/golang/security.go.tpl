@@ -0,0 +1,141 @@
+package {{ .package }}
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Authenticator authenticates the requests by the security schemes
+// stride:generate authenticator
+type Authenticator interface {
+	{{- range .schemes }}
+	{{ .Name | camelize }}Authenticator
+	{{- end }}
+}
+{{ range .schemes }}
+{{- $name := .Name | camelize }}
+{{- comment (printf "%sAuthenticator authenticates the requests by the %s security scheme" $name (.Name | dasherize)) }}
+{{- comment .Description }}
+{{- comment "stride:generate" (key .Name "authenticator") }}
+type {{ $name }}Authenticator interface {
+	{{- if eq .Type "apiKey" }}
+	Authenticate{{ $name }}(ctx context.Context, key string) (context.Context, error)
+	{{- else if eq .Type "http" }}
+	{{- if eq .Scheme "basic" }}
+	Authenticate{{ $name }}(ctx context.Context, username, password string) (context.Context, error)
+	{{- else }}
+	Authenticate{{ $name }}(ctx context.Context, token string) (context.Context, error)
+	{{- end }}
+	{{- else }}
+	Authenticate{{ $name }}(ctx context.Context, token string, scopes []string) (context.Context, error)
+	{{- end }}
+}
+{{ end }}
+// stride:generate authenticate-func
+type authenticateFunc func(r *http.Request) (context.Context, error)
+
+// authenticate authenticates the requests by any of the requirements. A
+// requirement is satisfied if all of its functions succeed.
+// stride:generate authenticate
+func authenticate(requirements ...[]authenticateFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			for _, requirement := range requirements {
+				if ctx, err := authenticateAll(r, requirement); err == nil {
+					next.ServeHTTP(w, r.WithContext(ctx))
+					return
+				}
+			}
+
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// stride:generate authenticate-all
+func authenticateAll(r *http.Request, requirement []authenticateFunc) (context.Context, error) {
+	ctx := r.Context()
+
+	for _, fn := range requirement {
+		next, err := fn(r.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		ctx = next
+	}
+
+	return ctx, nil
+}
+{{ range .schemes }}
+{{- $name := .Name | camelize }}
+{{- comment "stride:generate" (key "authenticate" .Name) }}
+func authenticate{{ $name }}(authenticator Authenticator, scopes ...string) authenticateFunc {
+	return func(r *http.Request) (context.Context, error) {
+		if authenticator == nil {
+			return nil, fmt.Errorf("{{ .Name | dasherize }}: authenticator not found")
+		}
+		{{- if eq .Type "apiKey" }}
+		{{- if eq .In "query" }}
+
+		key := r.URL.Query().Get({{ printf "%q" .Key }})
+		{{- else if eq .In "cookie" }}
+
+		var key string
+
+		if cookie, err := r.Cookie({{ printf "%q" .Key }}); err == nil {
+			key = cookie.Value
+		}
+		{{- else }}
+
+		key := r.Header.Get({{ printf "%q" .Key }})
+		{{- end }}
+
+		if key == "" {
+			return nil, fmt.Errorf("{{ .Name | dasherize }}: key not found")
+		}
+
+		return authenticator.Authenticate{{ $name }}(r.Context(), key)
+		{{- else if and (eq .Type "http") (eq .Scheme "basic") }}
+
+		username, password, ok := r.BasicAuth()
+		if !ok {
+			return nil, fmt.Errorf("{{ .Name | dasherize }}: credentials not found")
+		}
+
+		return authenticator.Authenticate{{ $name }}(r.Context(), username, password)
+		{{- else }}
+
+		token, ok := authorization(r, {{ if eq .Type "http" }}{{ printf "%q" .Scheme }}{{ else }}"bearer"{{ end }})
+		if !ok {
+			return nil, fmt.Errorf("{{ .Name | dasherize }}: token not found")
+		}
+
+		{{- if eq .Type "http" }}
+
+		return authenticator.Authenticate{{ $name }}(r.Context(), token)
+		{{- else }}
+
+		return authenticator.Authenticate{{ $name }}(r.Context(), token, scopes)
+		{{- end }}
+		{{- end }}
+	}
+}
+{{ end }}
+// authorization returns the credentials of the authorization header if it
+// has the given scheme
+// stride:generate authorization
+func authorization(r *http.Request, scheme string) (string, bool) {
+	parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+
+	if len(parts) != 2 || !strings.EqualFold(parts[0], scheme) {
+		return "", false
+	}
+
+	token := strings.TrimSpace(parts[1])
+	return token, token != ""
+}
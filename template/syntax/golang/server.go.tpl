@@ -1,10 +1,13 @@
-package service
+package {{ .package }}
 
 import (
 	"net/http"
 
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
+	{{- if .validations }}
+	"github.com/phogolabs/restify"
+	{{- end }}
 )
 
 // Config is the service config
@@ -12,6 +15,10 @@ import (
 type Config struct {
 	// stride:generate addr
 	Addr string
+	{{- if .secured }}
+	// stride:generate authenticator
+	Authenticator Authenticator
+	{{- end }}
 }
 
 // Route represents a mountable route
@@ -23,6 +30,10 @@ type Route interface {
 // NewServer creates a new server
 // stride:generate new-server
 func NewServer(config *Config) *http.Server {
+	{{- if .validations }}
+	// the binder validates the custom tags of the models
+	restify.SetValidator({{ .models }}NewValidator())
+{{ end }}
 	router := chi.NewRouter()
 	router.Use(middleware.StripSlashes)
 	router.Use(middleware.RealIP)
@@ -33,8 +44,12 @@ func NewServer(config *Config) *http.Server {
 	routes := []Route{
 	  {{- range .controllers }}
     // stride:generate
+	  {{- if .IsSecured }}
+	  &{{ .Name | camelize }}API{Authenticator: config.Authenticator},
+	  {{- else }}
 	  &{{ .Name | camelize }}API{},
 	  {{- end }}
+	  {{- end }}
   }
 
 	for _, route := range routes {
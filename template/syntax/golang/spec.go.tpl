@@ -1,4 +1,4 @@
-package service_test
+package {{ .package }}_test
 
 import (
 	"github.com/go-chi/chi"
@@ -6,7 +6,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
-	"{{ .project }}"
+	{{ .package }} "{{ .project }}"
 )
 
 var _ = Describe("{{ .receiver }}", func() {
@@ -20,7 +20,7 @@ var _ = Describe("{{ .receiver }}", func() {
 	BeforeEach(func() {
 		router = chi.NewRouter()
 
-		controller := &service.CustomerAPI{}
+		controller := &{{ .package }}.{{ .receiver }}{}
 		controller.Mount(router)
 
 		Expect(router.Routes()).NotTo(BeEmpty())
@@ -32,7 +32,7 @@ var _ = Describe("{{ .receiver }}", func() {
 	{{ range .operations }}
 
 	Describe("{{ .Method | uppercase }} {{ .Path }}", func() {
-		// TODO: Implement the test cases for {{ .Name | camelize }} operation
+		// TODO: Implement the test cases for {{ .Identifier | camelize }} operation
 	})
 	{{ end }}
 })
@@ -0,0 +1,27 @@
+{{- comment "WriteTo streams the body to the writer" }}
+{{- comment "stride:generate" (key .receiver "WriteTo") }}
+func (x {{ .receiver | camelize }}) WriteTo(w io.Writer) (int64, error) {
+	{{- if .pointer }}
+	if x.Body == nil || x.Body.Reader == nil {
+	{{- else }}
+	if x.Body.Reader == nil {
+	{{- end }}
+		return 0, nil
+	}
+
+	return io.Copy(w, x.Body.Reader)
+}
+
+{{- comment "ContentType returns the content type of the body" }}
+{{- comment "stride:generate" (key .receiver "ContentType") }}
+func (x {{ .receiver | camelize }}) ContentType() string {
+	{{- if .pointer }}
+	if x.Body != nil && x.Body.ContentType != "" {
+	{{- else }}
+	if x.Body.ContentType != "" {
+	{{- end }}
+		return x.Body.ContentType
+	}
+
+	return {{ printf "%q" .content }}
+}
@@ -0,0 +1,63 @@
+{{- comment "MarshalJSON marshals into valid JSON" }}
+{{- comment "stride:generate" (key .receiver "MarshalJSON") }}
+func (x {{ .receiver | camelize }}) MarshalJSON() ([]byte, error) {
+	var kind string
+
+	switch x.Value.(type) {
+	case nil:
+		return []byte("null"), nil
+	{{- range .variants }}
+	case *{{ .kind }}:
+		kind = {{ printf "%q" .value }}
+	{{- end }}
+	default:
+		return nil, fmt.Errorf("{{ .receiver | dasherize }}: unknown variant %T", x.Value)
+	}
+
+	data, err := json.Marshal(x.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	object := map[string]json.RawMessage{}
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	if object[{{ printf "%q" .discriminator }}], err = json.Marshal(kind); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(object)
+}
+
+{{- comment "UnmarshalJSON unmarshals from valid JSON" }}
+{{- comment "stride:generate" (key .receiver "UnmarshalJSON") }}
+func (x *{{ .receiver | camelize }}) UnmarshalJSON(data []byte) error {
+	object := struct {
+		Kind string `json:{{ printf "%q" .discriminator }}`
+	}{}
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	switch object.Kind {
+	{{- range .variants }}
+	case {{ printf "%q" .value }}:
+		x.Value = &{{ .kind }}{}
+	{{- end }}
+	default:
+		return fmt.Errorf("{{ .receiver | dasherize }}: unknown discriminator value %q", object.Kind)
+	}
+
+	return json.Unmarshal(data, x.Value)
+}
+{{- $marker := .marker }}
+{{- range .variants }}
+
+{{- comment (printf "%s marks %s as a variant of %s" $marker .kind $.receiver) }}
+{{- comment "stride:generate" (key .kind $marker) }}
+func (x *{{ .kind }}) {{ $marker }}() {}
+{{- end }}
@@ -0,0 +1,23 @@
+{{- if and (eq .method "ValidateRequest") .directional }}
+{{- comment "ValidateRequest validates the value of a request and returns the field errors." }}
+{{- comment "The read-only properties must not be set." }}
+{{- else if eq .method "ValidateRequest" }}
+{{- comment "ValidateRequest validates the value of a request and returns the field errors" }}
+{{- else if and (eq .method "ValidateResponse") .directional }}
+{{- comment "ValidateResponse validates the value of a response and returns the field errors." }}
+{{- comment "The write-only properties must not be set." }}
+{{- else if eq .method "ValidateResponse" }}
+{{- comment "ValidateResponse validates the value of a response and returns the field errors" }}
+{{- else }}
+{{- comment "Validate validates the value and returns the field errors" }}
+{{- end }}
+{{- comment "stride:generate" (key .receiver .method) }}
+func (x {{ .receiver | camelize }}) {{ .method }}() error {
+	{{- if .delegate }}
+	return x.{{ .delegate }}()
+	{{- else }}
+	errs := ValidationErrors{}
+	{{ .body }}
+	return errs.Err()
+	{{- end }}
+}
@@ -0,0 +1,213 @@
+package {{ .package }}
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// stride:generate patterns
+var patterns sync.Map
+{{- if .tags }}
+
+// RegisterValidations registers the custom validations used by the generated types
+// stride:generate register-validations
+func RegisterValidations(v *validator.Validate) error {
+	if err := v.RegisterValidation("regexp", validateRegexp); err != nil {
+		return err
+	}
+
+	return v.RegisterValidation("multipleof", validateMultipleOf)
+}
+
+// NewValidator creates a validator that knows the custom validations
+// stride:generate new-validator
+func NewValidator() *validator.Validate {
+	v := validator.New()
+
+	// the custom validations have valid names and functions
+	if err := RegisterValidations(v); err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// stride:generate validate-regexp
+func validateRegexp(field validator.FieldLevel) bool {
+	value := field.Field().String()
+
+	// the presence is validated by the required tag
+	if value == "" {
+		return true
+	}
+
+	pattern, err := compileRegexp(field.Param())
+	if err != nil {
+		return false
+	}
+
+	return pattern.MatchString(value)
+}
+
+// stride:generate validate-multiple-of
+func validateMultipleOf(field validator.FieldLevel) bool {
+	factor, err := strconv.ParseFloat(field.Param(), 64)
+	if err != nil {
+		return false
+	}
+
+	var value float64
+
+	switch item := field.Field(); item.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value = float64(item.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value = float64(item.Uint())
+	case reflect.Float32, reflect.Float64:
+		value = item.Float()
+	default:
+		return false
+	}
+
+	return multipleOf(value, factor)
+}
+{{- end }}
+
+// stride:generate compile-regexp
+func compileRegexp(text string) (*regexp.Regexp, error) {
+	if pattern, ok := patterns.Load(text); ok {
+		return pattern.(*regexp.Regexp), nil
+	}
+
+	pattern, err := regexp.Compile(text)
+	if err != nil {
+		return nil, err
+	}
+
+	patterns.Store(text, pattern)
+	return pattern, nil
+}
+
+// stride:generate multiple-of
+func multipleOf(value, factor float64) bool {
+	const epsilon = 1e-9
+
+	remainder := math.Abs(math.Mod(value, factor))
+	return remainder < epsilon || math.Abs(remainder-math.Abs(factor)) < epsilon
+}
+{{- if .methods }}
+
+// ValidationError represents a validation error of a field
+// stride:generate validation-error
+type ValidationError struct {
+	// stride:generate field
+	Field string `json:"field"`
+	// stride:generate message
+	Message string `json:"message"`
+}
+
+// Error returns the error message
+// stride:generate validation-error:error
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors represents a list of field validation errors
+// stride:generate validation-errors
+type ValidationErrors []*ValidationError
+
+// Error returns the error message
+// stride:generate validation-errors:error
+func (e ValidationErrors) Error() string {
+	messages := []string{}
+
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Add adds an error for the given field path
+// stride:generate validation-errors:add
+func (e *ValidationErrors) Add(field, format string, args ...interface{}) {
+	*e = append(*e, &ValidationError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Merge adds the errors of a nested value under the given field path
+// stride:generate validation-errors:merge
+func (e *ValidationErrors) Merge(field string, err error) {
+	switch errs := err.(type) {
+	case nil:
+	case ValidationErrors:
+		for _, item := range errs {
+			*e = append(*e, &ValidationError{
+				Field:   fieldPath(field, item.Field),
+				Message: item.Message,
+			})
+		}
+	default:
+		e.Add(field, "%v", err)
+	}
+}
+
+// Err returns the errors or nil if there are not any
+// stride:generate validation-errors:err
+func (e ValidationErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// stride:generate field-path
+func fieldPath(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	default:
+		return parent + "." + child
+	}
+}
+
+// stride:generate field-index
+func fieldIndex(parent string, index interface{}) string {
+	return fmt.Sprintf("%s[%v]", parent, index)
+}
+
+// stride:generate match-pattern
+func matchPattern(text, value string) bool {
+	pattern, err := compileRegexp(text)
+	if err != nil {
+		return false
+	}
+
+	return pattern.MatchString(value)
+}
+
+// stride:generate unique-key
+func uniqueKey(value interface{}) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+{{- end }}
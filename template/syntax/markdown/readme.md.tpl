@@ -8,15 +8,24 @@ API version: {{ .version }}
 
 This server was generated by [stride](https://github.com/phogolabs/stride) project. 
 
+{{- if .links }}
+### Links
+
+The responses link to the following operations:
+
+| Operation | Response | Link | Target | Parameters |
+| --- | --- | --- | --- | --- |
+{{- range .links }}
+| `{{ .method }} {{ .path }}` | {{ .code }} | {{ .name }}{{ if .description }} - {{ .description }}{{ end }} | `{{ .target }}` | {{ .parameters }} |
+{{- end }}
+
+{{ end -}}
 ### Prerequisite
 
 You should have the following tools installed:
 
 - [Golang](https://www.golang.org) language (at least version 1.12)
 
-*Make sure that the environment variable `GOPATH` is set. The `GOPATH` directory
-will be used as workspace directory for all GO projects.*
-
 ### Getting Started
 
 if you host the source code on git and your project has a private dependencies
@@ -33,8 +42,7 @@ The dependencies are managed by Golang's
 ```bash
 $ export GO111MODULE=on
 $ export PROJECT_PKG={{ .project }}
-$ cd $GOPATH/src/$PROJECT_PKG
-$ go get ./...
+$ go mod tidy
 ```
 
 ### Running the tests
@@ -0,0 +1,257 @@
+/** ClientOptions configures the API clients */
+export interface ClientOptions {
+  /** baseUrl is the url of the server */
+  baseUrl: string;
+  /** headers are sent with every request */
+  headers?: Record<string, string>;
+  /** fetch replaces the global fetch function */
+  fetch?: typeof fetch;
+}
+
+/** ApiError is thrown when the response status code is not defined by the operation */
+export class ApiError extends Error {
+  constructor(readonly status: number, readonly headers: Headers, readonly body: unknown) {
+    super(`unexpected response status code: ${status}`);
+    this.name = "ApiError";
+  }
+}
+
+/** Style is the serialization style of a parameter */
+export type Style = "simple" | "label" | "matrix" | "form" | "spaceDelimited" | "pipeDelimited" | "deepObject";
+
+/** Parameter is a parameter of a request */
+export interface Parameter {
+  name: string;
+  in: "path" | "query" | "header" | "cookie";
+  style: Style;
+  explode: boolean;
+  value: unknown;
+}
+
+/** Request is a request of an operation */
+export interface Request {
+  method: string;
+  path: string;
+  parameters: Parameter[];
+  contentType?: string;
+  body?: unknown;
+  accept: string[];
+}
+
+interface Item {
+  values: string[];
+  fields?: Array<[string, string]>;
+}
+
+/** send sends the request to the server */
+export async function send(options: ClientOptions, request: Request): Promise<Response> {
+  const query: string[] = [];
+  const cookies: string[] = [];
+  const headers = new Headers(options.headers);
+
+  let path = request.path;
+
+  for (const parameter of request.parameters) {
+    const item = split(parameter.value);
+
+    if (item === undefined) {
+      continue;
+    }
+
+    switch (parameter.in) {
+      case "path":
+        path = path.split(`{${parameter.name}}`).join(encodePath(parameter, item));
+        break;
+      case "query":
+        for (const [key, value] of encodeQuery(parameter, item)) {
+          query.push(`${encodeURIComponent(key)}=${encodeURIComponent(value)}`);
+        }
+        break;
+      case "header":
+        // the simple style is the only one allowed for the headers
+        headers.set(parameter.name, join(item, ",", parameter.explode));
+        break;
+      case "cookie":
+        for (const [key, value] of encodeCookie(parameter, item)) {
+          cookies.push(`${key}=${value}`);
+        }
+        break;
+    }
+  }
+
+  if (cookies.length > 0) {
+    headers.set("Cookie", cookies.join("; "));
+  }
+
+  if (request.accept.length > 0) {
+    headers.set("Accept", request.accept.join(", "));
+  }
+
+  let body: string | undefined;
+
+  if (request.body !== undefined && request.body !== null && request.contentType) {
+    body = encodeBody(request.contentType, request.body);
+    headers.set("Content-Type", request.contentType);
+  }
+
+  let url = options.baseUrl.replace(/\/+$/, "") + path;
+
+  if (query.length > 0) {
+    url = url + "?" + query.join("&");
+  }
+
+  const fetcher = options.fetch || fetch;
+  return fetcher(url, { method: request.method, headers, body });
+}
+
+/** decode decodes the body of the response */
+export async function decode(response: Response): Promise<unknown> {
+  const text = await response.text();
+
+  if (text === "") {
+    return undefined;
+  }
+
+  const kind = response.headers.get("Content-Type") || "application/json";
+
+  if (/json/i.test(kind)) {
+    return JSON.parse(text);
+  }
+
+  return text;
+}
+
+function encodeBody(kind: string, value: unknown): string {
+  if (/json/i.test(kind)) {
+    return JSON.stringify(value);
+  }
+
+  if (typeof value === "string") {
+    return value;
+  }
+
+  throw new Error(`unsupported request content-type: ${kind}`);
+}
+
+function encodePath(parameter: Parameter, item: Item): string {
+  const name = parameter.name;
+  const values = item.values.map(encodeURIComponent);
+  const fields = item.fields && item.fields.map(([key, value]): [string, string] => [encodeURIComponent(key), encodeURIComponent(value)]);
+  const escaped: Item = { values, fields };
+
+  switch (parameter.style) {
+    case "label":
+      return "." + join(escaped, parameter.explode ? "." : ",", parameter.explode);
+    case "matrix":
+      if (fields && parameter.explode) {
+        return ";" + join(escaped, ";", true);
+      }
+
+      if (fields) {
+        return ";" + name + "=" + join(escaped, ",", false);
+      }
+
+      if (parameter.explode) {
+        return ";" + values.map((value) => name + "=" + value).join(";");
+      }
+
+      return ";" + name + "=" + values.join(",");
+    default:
+      return join(escaped, ",", parameter.explode);
+  }
+}
+
+function encodeQuery(parameter: Parameter, item: Item): Array<[string, string]> {
+  const name = parameter.name;
+
+  if (item.fields && parameter.style === "deepObject") {
+    return item.fields.map(([key, value]): [string, string] => [`${name}[${key}]`, value]);
+  }
+
+  if (item.fields && parameter.explode) {
+    return item.fields;
+  }
+
+  if (item.fields) {
+    return [[name, join(item, ",", false)]];
+  }
+
+  if (parameter.explode) {
+    return item.values.map((value): [string, string] => [name, value]);
+  }
+
+  switch (parameter.style) {
+    case "spaceDelimited":
+      return [[name, item.values.join(" ")]];
+    case "pipeDelimited":
+      return [[name, item.values.join("|")]];
+    default:
+      return [[name, item.values.join(",")]];
+  }
+}
+
+function encodeCookie(parameter: Parameter, item: Item): Array<[string, string]> {
+  const name = parameter.name;
+
+  if (item.fields && parameter.explode) {
+    return item.fields;
+  }
+
+  if (item.fields) {
+    return [[name, join(item, ",", false)]];
+  }
+
+  if (parameter.explode) {
+    return item.values.map((value): [string, string] => [name, value]);
+  }
+
+  return [[name, item.values.join(",")]];
+}
+
+// split returns the values of an array or the fields of an object
+function split(value: unknown): Item | undefined {
+  if (value === undefined || value === null) {
+    return undefined;
+  }
+
+  if (Array.isArray(value)) {
+    return { values: value.map(format) };
+  }
+
+  if (typeof value === "object" && !(value instanceof Date)) {
+    const record = value as Record<string, unknown>;
+    const fields = Object.keys(record)
+      .filter((key) => record[key] !== undefined && record[key] !== null)
+      .map((key): [string, string] => [key, format(record[key])]);
+
+    return { values: [], fields };
+  }
+
+  return { values: [format(value)] };
+}
+
+function join(item: Item, separator: string, explode: boolean): string {
+  if (item.fields === undefined) {
+    return item.values.join(separator);
+  }
+
+  const items: string[] = [];
+
+  for (const [key, value] of item.fields) {
+    if (explode) {
+      items.push(key + "=" + value);
+    } else {
+      items.push(key, value);
+    }
+  }
+
+  return items.join(separator);
+}
+
+function format(value: unknown): string {
+  if (value instanceof Date) {
+    return value.toISOString();
+  }
+
+  return String(value);
+}